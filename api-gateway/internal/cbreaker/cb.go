@@ -1,25 +1,272 @@
 package cbreaker
 
 import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/sony/gobreaker/v2"
 	"go.uber.org/zap"
-	"time"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Modes an operator can put a breaker in. Auto leaves it to the
+// failure ratio.
+const (
+	ModeAuto   = "auto"
+	ModeOpen   = "open"
+	ModeClosed = "closed"
+)
+
+var (
+	stateGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gateway_circuit_breaker_state",
+		Help: "Circuit breaker state: 0 closed, 1 half-open, 2 open",
+	}, []string{"breaker"})
+	transitions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gateway_circuit_breaker_transitions_total",
+		Help: "Circuit breaker state changes, forced ones included",
+	}, []string{"breaker", "from", "to", "forced"})
+)
+
+var (
+	mu       sync.Mutex
+	breakers = map[string]*Breaker{}
 )
 
-func NewCb(name string, log *zap.Logger) *gobreaker.CircuitBreaker[any] {
-	st := gobreaker.Settings{
-		Name:        name,
-		MaxRequests: 5,
-		Interval:    time.Minute,
-		Timeout:     5 * time.Minute,
+// Settings of one breaker. Counts are kept over Interval in buckets of
+// BucketPeriod, and the breaker opens once at least MinRequests were
+// made and FailureRatio of them failed.
+type Settings struct {
+	MaxRequests  uint32
+	Interval     time.Duration
+	BucketPeriod time.Duration
+	Timeout      time.Duration
+	MinRequests  uint32
+	FailureRatio float64
+}
+
+// LoadSettings reads CB_<NAME>_* for the service, e.g. CB_ORDERS_TIMEOUT.
+func LoadSettings(name string) Settings {
+	prefix := "CB_" + strings.ToUpper(name) + "_"
+	return Settings{
+		MaxRequests:  uint32(envInt(prefix+"MAX_REQUESTS", 5)),
+		Interval:     envDuration(prefix+"INTERVAL", time.Minute),
+		BucketPeriod: envDuration(prefix+"BUCKET_PERIOD", 10*time.Second),
+		Timeout:      envDuration(prefix+"TIMEOUT", 5*time.Minute),
+		MinRequests:  uint32(envInt(prefix+"MIN_REQUESTS", 10)),
+		FailureRatio: envFloat(prefix+"FAILURE_RATIO", 0.5),
+	}
+}
+
+type Breaker struct {
+	name     string
+	settings Settings
+	log      *zap.Logger
+	cb       atomic.Pointer[gobreaker.CircuitBreaker[any]]
+	// mode is changed by operators only, under forceMu
+	mode    atomic.Value
+	forceMu sync.Mutex
+}
+
+// NewCb creates the breaker of a downstream service and registers it
+// for the admin endpoints.
+func NewCb(name string, log *zap.Logger) *Breaker {
+	b := &Breaker{
+		name:     name,
+		settings: LoadSettings(name),
+		log:      log,
+	}
+	b.mode.Store(ModeAuto)
+	b.cb.Store(b.newCircuit())
+	stateGauge.WithLabelValues(name).Set(float64(gobreaker.StateClosed))
+
+	mu.Lock()
+	breakers[name] = b
+	mu.Unlock()
+
+	return b
+}
+
+func (b *Breaker) newCircuit() *gobreaker.CircuitBreaker[any] {
+	s := b.settings
+	return gobreaker.NewCircuitBreaker[any](gobreaker.Settings{
+		Name:         b.name,
+		MaxRequests:  s.MaxRequests,
+		Interval:     s.Interval,
+		BucketPeriod: s.BucketPeriod,
+		Timeout:      s.Timeout,
+		IsSuccessful: func(err error) bool {
+			return !IsFailure(err)
+		},
 		ReadyToTrip: func(counts gobreaker.Counts) bool {
-			return counts.ConsecutiveFailures > 5
+			if counts.Requests < s.MinRequests {
+				return false
+			}
+			return float64(counts.TotalFailures)/float64(counts.Requests) >= s.FailureRatio
 		},
 		OnStateChange: func(name string, from gobreaker.State, to gobreaker.State) {
-			log.Error("CB changed",
+			b.log.Error("CB changed",
+				zap.String("breaker", name),
 				zap.String("from", from.String()),
 				zap.String("to", to.String()))
+			transitions.WithLabelValues(name, from.String(), to.String(), "false").Inc()
+			stateGauge.WithLabelValues(name).Set(float64(to))
+		},
+	})
+}
+
+// IsFailure tells errors of an unhealthy service from answers to bad
+// requests. A wrong password or a missing record must not count, or any
+// client could open the breaker for everyone.
+func IsFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal,
+		codes.Unknown, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
+
+func (b *Breaker) Execute(fn func() (any, error)) (any, error) {
+	switch b.mode.Load().(string) {
+	case ModeOpen:
+		return nil, gobreaker.ErrOpenState
+	case ModeClosed:
+		return fn()
+	}
+	return b.cb.Load().Execute(fn)
+}
+
+func (b *Breaker) Name() string {
+	return b.name
+}
+
+// State is what callers see, a forced mode wins over the circuit.
+func (b *Breaker) State() gobreaker.State {
+	switch b.mode.Load().(string) {
+	case ModeOpen:
+		return gobreaker.StateOpen
+	case ModeClosed:
+		return gobreaker.StateClosed
+	}
+	return b.cb.Load().State()
+}
+
+// Force pins the breaker open or closed. Going back to auto starts
+// from a closed circuit with fresh counts.
+func (b *Breaker) Force(mode string) error {
+	if mode != ModeAuto && mode != ModeOpen && mode != ModeClosed {
+		return fmt.Errorf("unknown mode %q", mode)
+	}
+
+	b.forceMu.Lock()
+	defer b.forceMu.Unlock()
+
+	from := b.State()
+	if mode == ModeAuto {
+		b.cb.Store(b.newCircuit())
+	}
+	b.mode.Store(mode)
+	to := b.State()
+
+	b.log.Warn("CB forced",
+		zap.String("breaker", b.name),
+		zap.String("mode", mode),
+		zap.String("from", from.String()),
+		zap.String("to", to.String()))
+	if from != to {
+		transitions.WithLabelValues(b.name, from.String(), to.String(), "true").Inc()
+	}
+	stateGauge.WithLabelValues(b.name).Set(float64(to))
+
+	return nil
+}
+
+type Status struct {
+	Name     string         `json:"name"`
+	State    string         `json:"state"`
+	Mode     string         `json:"mode"`
+	Counts   map[string]any `json:"counts"`
+	Settings map[string]any `json:"settings"`
+}
+
+func (b *Breaker) Status() Status {
+	counts := b.cb.Load().Counts()
+	s := b.settings
+	return Status{
+		Name:  b.name,
+		State: b.State().String(),
+		Mode:  b.mode.Load().(string),
+		Counts: map[string]any{
+			"requests":              counts.Requests,
+			"total_successes":       counts.TotalSuccesses,
+			"total_failures":        counts.TotalFailures,
+			"consecutive_successes": counts.ConsecutiveSuccesses,
+			"consecutive_failures":  counts.ConsecutiveFailures,
 		},
+		Settings: map[string]any{
+			"max_requests":  s.MaxRequests,
+			"interval":      s.Interval.String(),
+			"bucket_period": s.BucketPeriod.String(),
+			"timeout":       s.Timeout.String(),
+			"min_requests":  s.MinRequests,
+			"failure_ratio": s.FailureRatio,
+		},
+	}
+}
+
+// Get returns a registered breaker by service name.
+func Get(name string) (*Breaker, bool) {
+	mu.Lock()
+	defer mu.Unlock()
+	b, ok := breakers[name]
+	return b, ok
+}
+
+// All returns the registered breakers ordered by name.
+func All() []*Breaker {
+	mu.Lock()
+	defer mu.Unlock()
+
+	all := make([]*Breaker, 0, len(breakers))
+	for _, b := range breakers {
+		all = append(all, b)
+	}
+	slices.SortFunc(all, func(a, b *Breaker) int {
+		return strings.Compare(a.name, b.name)
+	})
+	return all
+}
+
+func envInt(key string, def int) int {
+	if v, err := strconv.Atoi(os.Getenv(key)); err == nil && v > 0 {
+		return v
+	}
+	return def
+}
+
+func envFloat(key string, def float64) float64 {
+	if v, err := strconv.ParseFloat(os.Getenv(key), 64); err == nil && v > 0 && v <= 1 {
+		return v
+	}
+	return def
+}
+
+func envDuration(key string, def time.Duration) time.Duration {
+	if v, err := time.ParseDuration(os.Getenv(key)); err == nil && v > 0 {
+		return v
 	}
-	return gobreaker.NewCircuitBreaker[any](st)
+	return def
 }
@@ -0,0 +1,49 @@
+package cbreaker
+
+import (
+	"errors"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClientErrorsDoNotTrip(t *testing.T) {
+	tests := []struct {
+		code codes.Code
+		open bool
+	}{
+		{codes.InvalidArgument, false},
+		{codes.NotFound, false},
+		{codes.PermissionDenied, false},
+		{codes.Unauthenticated, false},
+		{codes.Unavailable, true},
+		{codes.DeadlineExceeded, true},
+		{codes.Internal, true},
+		{codes.Unknown, true},
+		{codes.ResourceExhausted, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code.String(), func(t *testing.T) {
+			b := NewCb("test-"+tt.code.String(), zap.NewNop())
+			for range b.settings.MinRequests * 2 {
+				b.Execute(func() (any, error) {
+					return nil, status.Error(tt.code, "failed")
+				})
+			}
+			if open := b.State().String() == "open"; open != tt.open {
+				t.Errorf("open = %v, want %v", open, tt.open)
+			}
+		})
+	}
+}
+
+func TestIsFailure(t *testing.T) {
+	if IsFailure(nil) {
+		t.Error("nil counted as failure")
+	}
+	if !IsFailure(errors.New("plain error")) {
+		t.Error("error without status not counted as failure")
+	}
+}
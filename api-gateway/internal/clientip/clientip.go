@@ -0,0 +1,163 @@
+// Package clientip finds the address of the client behind the proxies
+// we trust. Forwarding headers are honoured only when the connection
+// comes from a trusted proxy, otherwise anyone could pick their own IP.
+package clientip
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"os"
+	"strings"
+
+	ck "gateway/internal/contextKeys"
+)
+
+type Resolver struct {
+	trusted []netip.Prefix
+}
+
+// New reads TRUSTED_PROXIES, a comma separated list of CIDRs or single
+// addresses. Without it forwarding headers are ignored.
+func New() (*Resolver, error) {
+	r := &Resolver{}
+
+	for _, s := range strings.Split(os.Getenv("TRUSTED_PROXIES"), ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if !strings.Contains(s, "/") {
+			addr, err := netip.ParseAddr(s)
+			if err != nil {
+				return nil, fmt.Errorf("trusted proxy %q: %w", s, err)
+			}
+			addr = addr.Unmap()
+			r.trusted = append(r.trusted, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			return nil, fmt.Errorf("trusted proxy %q: %w", s, err)
+		}
+		r.trusted = append(r.trusted, p.Masked())
+	}
+
+	return r, nil
+}
+
+// Middleware resolves the client once and keeps it in the context.
+func (r *Resolver) Middleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			ctx := context.WithValue(req.Context(), ck.IPKey, r.Resolve(req))
+			next.ServeHTTP(w, req.WithContext(ctx))
+		})
+	}
+}
+
+// Resolve walks the forwarding chain from the nearest hop back and
+// stops at the first address that is not a trusted proxy. Forwarded
+// wins over X-Forwarded-For, X-Real-IP is the last resort.
+func (r *Resolver) Resolve(req *http.Request) netip.Addr {
+	client, ok := parseHost(req.RemoteAddr)
+	if !ok || !r.isTrusted(client) {
+		return client
+	}
+
+	hops := forwardedFor(req.Header.Values("Forwarded"))
+	if len(hops) == 0 {
+		hops = splitList(req.Header.Values("X-Forwarded-For"))
+	}
+	if len(hops) == 0 {
+		if addr, ok := parseHost(req.Header.Get("X-Real-IP")); ok {
+			return addr
+		}
+		return client
+	}
+
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, ok := parseHost(hops[i])
+		if !ok {
+			// "unknown" or an obfuscated node, nothing beyond it is usable
+			break
+		}
+		client = addr
+		if !r.isTrusted(addr) {
+			break
+		}
+	}
+	return client
+}
+
+func (r *Resolver) isTrusted(addr netip.Addr) bool {
+	for _, p := range r.trusted {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// FromContext returns the address stored by Middleware, invalid if the
+// middleware did not run.
+func FromContext(ctx context.Context) netip.Addr {
+	addr, _ := ctx.Value(ck.IPKey).(netip.Addr)
+	return addr
+}
+
+// Bucket is the key for per client limits. An IPv6 client usually owns
+// the whole /64, so it is counted as one.
+func Bucket(addr netip.Addr) string {
+	if !addr.IsValid() {
+		return "unknown"
+	}
+	if addr.Is6() {
+		p, _ := addr.Prefix(64)
+		return p.Addr().String()
+	}
+	return addr.String()
+}
+
+// forwardedFor collects the for= parameters of RFC 7239 headers.
+func forwardedFor(values []string) []string {
+	var hops []string
+	for _, elem := range splitList(values) {
+		for _, pair := range strings.Split(elem, ";") {
+			k, v, ok := strings.Cut(strings.TrimSpace(pair), "=")
+			if ok && strings.EqualFold(k, "for") {
+				hops = append(hops, strings.Trim(v, `"`))
+			}
+		}
+	}
+	return hops
+}
+
+func splitList(values []string) []string {
+	var out []string
+	for _, v := range values {
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				out = append(out, s)
+			}
+		}
+	}
+	return out
+}
+
+// parseHost accepts "ip", "ip:port", "[ipv6]" and "[ipv6]:port".
+func parseHost(s string) (netip.Addr, bool) {
+	s = strings.TrimSpace(s)
+	if host, _, err := net.SplitHostPort(s); err == nil {
+		s = host
+	}
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "["), "]")
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap().WithZone(""), true
+}
@@ -0,0 +1,102 @@
+package clientip
+
+import (
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     string
+		want    int
+		wantErr bool
+	}{
+		{"unset", "", 0, false},
+		{"cidrs and addresses", "10.0.0.0/8, 192.168.1.1 ,fd00::/8,", 3, false},
+		{"bad address", "10.0.0.300", 0, true},
+		{"bad prefix", "10.0.0.0/33", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TRUSTED_PROXIES", tt.env)
+			r, err := New()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && len(r.trusted) != tt.want {
+				t.Errorf("trusted = %v, want %d prefixes", r.trusted, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	t.Setenv("TRUSTED_PROXIES", "10.0.0.0/8,2001:db8:ffff::1")
+	r, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		remote  string
+		headers map[string]string
+		want    string
+	}{
+		{"direct", "203.0.113.9:4000", nil, "203.0.113.9"},
+		{"untrusted peer ignores headers", "203.0.113.9:4000",
+			map[string]string{"X-Forwarded-For": "198.51.100.1", "X-Real-IP": "198.51.100.2"}, "203.0.113.9"},
+		{"trusted peer without headers", "10.1.2.3:4000", nil, "10.1.2.3"},
+		{"x-forwarded-for", "10.1.2.3:4000",
+			map[string]string{"X-Forwarded-For": "198.51.100.1"}, "198.51.100.1"},
+		{"spoofed hop before client", "10.1.2.3:4000",
+			map[string]string{"X-Forwarded-For": "1.2.3.4, 198.51.100.1, 10.9.9.9"}, "198.51.100.1"},
+		{"only trusted hops", "10.1.2.3:4000",
+			map[string]string{"X-Forwarded-For": "10.5.5.5, 10.9.9.9"}, "10.5.5.5"},
+		{"forwarded wins", "10.1.2.3:4000",
+			map[string]string{"Forwarded": `for=198.51.100.7;proto=https`, "X-Forwarded-For": "198.51.100.1"}, "198.51.100.7"},
+		{"forwarded ipv6 with port", "10.1.2.3:4000",
+			map[string]string{"Forwarded": `For="[2001:db8::7]:443"`}, "2001:db8::7"},
+		{"obfuscated hop", "10.1.2.3:4000",
+			map[string]string{"Forwarded": `for=198.51.100.7, for=unknown`}, "10.1.2.3"},
+		{"x-real-ip", "10.1.2.3:4000",
+			map[string]string{"X-Real-IP": "198.51.100.3"}, "198.51.100.3"},
+		{"bad x-real-ip", "10.1.2.3:4000",
+			map[string]string{"X-Real-IP": "nope"}, "10.1.2.3"},
+		{"ipv4 mapped peer", "[::ffff:10.1.2.3]:4000",
+			map[string]string{"X-Forwarded-For": "198.51.100.1"}, "198.51.100.1"},
+		{"trusted ipv6 peer", "[2001:db8:ffff::1]:4000",
+			map[string]string{"X-Forwarded-For": "198.51.100.1"}, "198.51.100.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			req.RemoteAddr = tt.remote
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			if got := r.Resolve(req); got != netip.MustParseAddr(tt.want) {
+				t.Errorf("Resolve = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBucket(t *testing.T) {
+	tests := []struct {
+		addr netip.Addr
+		want string
+	}{
+		{netip.MustParseAddr("198.51.100.1"), "198.51.100.1"},
+		{netip.MustParseAddr("2001:db8:1:2:3:4:5:6"), "2001:db8:1:2::"},
+		{netip.MustParseAddr("2001:db8:1:2::ffff"), "2001:db8:1:2::"},
+		{netip.Addr{}, "unknown"},
+	}
+	for _, tt := range tests {
+		if got := Bucket(tt.addr); got != tt.want {
+			t.Errorf("Bucket(%s) = %s, want %s", tt.addr, got, tt.want)
+		}
+	}
+}
@@ -1,13 +1,32 @@
 package contextkeys
 
-type contextKey struct{}
+// contextKey has to have a size, pointers to zero-size values may all
+// compare equal and the keys would overwrite each other.
+type contextKey int
 
-var (
-	UserKey = &contextKey{}
-	ReqKey  = &contextKey{}
+const (
+	UserKey contextKey = iota
+	ReqKey
+	// IPKey holds the resolved client netip.Addr
+	IPKey
 )
 
 type UserInfo struct {
-	Role   string
-	UserID string
+	Role     string
+	UserID   string
+	Verified bool
+	Perms    []string
+	// set only for API key requests
+	KeyID     string
+	RateLimit int64
+	// set only for OAuth access token requests
+	ClientID string
+	// set only while an admin impersonates UserID
+	ActorID string
+}
+
+// Delegated reports a caller acting through an API key or OAuth client
+// rather than the user's own session.
+func (ui UserInfo) Delegated() bool {
+	return ui.KeyID != "" || ui.ClientID != ""
 }
@@ -0,0 +1,61 @@
+package mdwr
+
+import (
+	"net/http"
+
+	ck "gateway/internal/contextKeys"
+	"gateway/internal/service"
+
+	"github.com/Votline/3l1/protos/apierr"
+	"github.com/Votline/3l1/protos/authz"
+)
+
+// Require rejects the request unless the caller holds every listed
+// permission. It must run after JWTAuth.
+func Require(perms ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			c := service.NewContext(w, r)
+			ui, ok := r.Context().Value(ck.UserKey).(ck.UserInfo)
+			if !ok || ui.UserID == "" {
+				c.Problem(apierr.Unauthenticated("user not authorized"))
+				return
+			}
+
+			set := authz.New(ui.Perms)
+			for _, p := range perms {
+				if !set.Has(p) {
+					c.Problem(apierr.PermissionDenied("missing permission: " + p))
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequireAny passes callers holding at least one of perms, for routes
+// whose own/any split is settled by the service.
+func RequireAny(perms ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			c := service.NewContext(w, r)
+			ui, ok := r.Context().Value(ck.UserKey).(ck.UserInfo)
+			if !ok || ui.UserID == "" {
+				c.Problem(apierr.Unauthenticated("user not authorized"))
+				return
+			}
+
+			set := authz.New(ui.Perms)
+			for _, p := range perms {
+				if set.Has(p) {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			c.Problem(apierr.PermissionDenied("missing permission"))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package mdwr
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"slices"
 	"strings"
@@ -10,47 +11,43 @@ import (
 
 	ck "gateway/internal/contextKeys"
 	"gateway/internal/service"
+
+	"github.com/Votline/3l1/protos/apierr"
 )
 
 type mdwr struct {
 	log *zap.Logger
-	ext func(string, string, string) (ck.UserInfo, error)
+	ext func(context.Context, string, string, string) (ck.UserInfo, error)
+	key func(context.Context, string, string) (ck.UserInfo, error)
+	oat func(context.Context, string, string) (ck.UserInfo, error)
 	svc service.Service
 }
 
-func NewMdwr(svc service.Service, ext func(string, string, string) (ck.UserInfo, error), log *zap.Logger) *mdwr {
+func NewMdwr(svc service.Service, ext func(context.Context, string, string, string) (ck.UserInfo, error), key, oat func(context.Context, string, string) (ck.UserInfo, error), log *zap.Logger) *mdwr {
 
-	return &mdwr{svc: svc, ext: ext, log: log}
+	return &mdwr{svc: svc, ext: ext, key: key, oat: oat, log: log}
 }
 
 func (m *mdwr) JWTAuth() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if isPublicRoute(r.URL.String()) {
+			if isPublicRoute(r.URL.Path) {
 				next.ServeHTTP(w, r)
 				return
 			}
+			c := service.NewContext(w, r)
 
 			authHeader := r.Header.Get("Authorization")
 			if authHeader == "" {
 				m.log.Error("Failed to extract Authorization Header")
-				http.Error(w, "Authorization header required", http.StatusBadRequest)
+				c.Problem(apierr.Unauthenticated("Authorization header required"))
 				return
 			}
 
 			parts := strings.Split(strings.TrimSpace(authHeader), " ")
-			if len(parts) < 2 || parts[0] != "Bearer" {
+			if len(parts) < 2 || (parts[0] != "Bearer" && parts[0] != "ApiKey") {
 				m.log.Error("Invalid Authorization format", zap.Int("len parts", len(parts)), zap.String("Part 0", parts[0]))
-				http.Error(w, "Invalid Authorization format", http.StatusBadRequest)
-				return
-			}
-
-			tokenString := parts[1]
-			sk, err := r.Cookie("session_key")
-
-			if err != nil {
-				m.log.Error("Failed to extract jwt data", zap.Error(err))
-				http.Error(w, err.Error(), http.StatusBadRequest)
+				c.Problem(apierr.Unauthenticated("Invalid Authorization format"))
 				return
 			}
 
@@ -61,7 +58,45 @@ func (m *mdwr) JWTAuth() func(http.Handler) http.Handler {
 			}
 			if rq == "" {
 				m.log.Error("Request ID missing from context and header (RequestID middleware must run before JWTAuth)")
-				http.Error(w, "internal error", http.StatusInternalServerError)
+				c.Problem(errors.New("request id missing"))
+				return
+			}
+
+			if parts[0] == "ApiKey" {
+				data, err := m.key(r.Context(), parts[1], rq)
+				if err != nil {
+					m.log.Error("Failed to authenticate API key", zap.Error(err))
+					c.Problem(err)
+					return
+				}
+
+				ctx := context.WithValue(r.Context(), ck.ReqKey, rq)
+				ctx = context.WithValue(ctx, ck.UserKey, data)
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+
+			tokenString := parts[1]
+			sk, err := r.Cookie("session_key")
+
+			// no session cookie: a bearer token issued to an OAuth client
+			if errors.Is(err, http.ErrNoCookie) {
+				data, err := m.oat(r.Context(), tokenString, rq)
+				if err != nil {
+					m.log.Error("Failed to authenticate access token", zap.Error(err))
+					w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+					c.Problem(err)
+					return
+				}
+
+				ctx := context.WithValue(r.Context(), ck.ReqKey, rq)
+				ctx = context.WithValue(ctx, ck.UserKey, data)
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+			if err != nil {
+				m.log.Error("Failed to extract jwt data", zap.Error(err))
+				c.Problem(apierr.Invalid("Malformed session cookie"))
 				return
 			}
 
@@ -72,10 +107,14 @@ func (m *mdwr) JWTAuth() func(http.Handler) http.Handler {
 			)
 
 
-			data, err := m.ext(tokenString, sk.Value, rq)
+			data, err := m.ext(r.Context(), tokenString, sk.Value, rq)
 			if err != nil {
 				m.log.Error("Failed to extract data from JWT token", zap.Error(err))
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+				c.Problem(err)
+				return
+			}
+
+			if data.ActorID != "" && !m.allowImpersonated(w, r, data, rq) {
 				return
 			}
 
@@ -86,12 +125,47 @@ func (m *mdwr) JWTAuth() func(http.Handler) http.Handler {
 	}
 }
 
+// allowImpersonated marks requests made by an admin acting as another
+// user and lets only read-only methods through.
+func (m *mdwr) allowImpersonated(w http.ResponseWriter, r *http.Request, data ck.UserInfo, rq string) bool {
+	w.Header().Set("X-Impersonated-By", data.ActorID)
+
+	readOnly := r.Method == http.MethodGet ||
+		r.Method == http.MethodHead ||
+		r.Method == http.MethodOptions
+
+	m.log.Warn("Impersonated request",
+		zap.String("request id", rq),
+		zap.String("actor id", data.ActorID),
+		zap.String("user id", data.UserID),
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+		zap.Bool("allowed", readOnly))
+
+	if !readOnly {
+		service.NewContext(w, r).Problem(apierr.PermissionDenied("read-only while impersonating"))
+	}
+	return readOnly
+}
+
 func isPublicRoute(path string) bool {
 	publicRotues := []string{
 		"/api/users/reg",
 		"/api/users/log",
+		"/api/users/log/mfa",
+		"/api/users/log/mfa/enroll",
+		"/api/users/log/mfa/confirm",
+		"/api/users/verify",
+		"/api/users/oauth/.well-known/openid-configuration",
+		"/api/users/oauth/jwks",
+		"/api/users/oauth/token",
+		"/api/users/oauth/introspect",
+		"/api/users/oauth/revoke",
+		"/api/users/oauth/userinfo",
 		"/metrics",
 		"/",
 	}
-	return slices.Contains(publicRotues, path)
+	return slices.Contains(publicRotues, path) ||
+		strings.HasPrefix(path, "/api/users/sso/") ||
+		strings.HasPrefix(path, "/api/users/erasures/")
 }
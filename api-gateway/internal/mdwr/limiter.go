@@ -0,0 +1,130 @@
+package mdwr
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+// Result of one limiter check. Reset is when the full budget is back,
+// RetryAfter when the next request would be allowed.
+type Result struct {
+	Allowed    bool
+	Limit      int64
+	Remaining  int64
+	Reset      time.Duration
+	RetryAfter time.Duration
+}
+
+// Limiter takes one request out of the budget of key under p.
+type Limiter interface {
+	Allow(ctx context.Context, key string, p Policy) (Result, error)
+}
+
+// Both scripts read the clock from Redis so that every gateway instance
+// agrees on it, and answer {allowed, remaining, reset ms, retry ms}.
+
+var tokenBucketScript = redis.NewScript(`
+local capacity = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = t[1] * 1000 + math.floor(t[2] / 1000)
+local rate = capacity / window
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil or ts == nil then
+	tokens = capacity
+	ts = now
+end
+tokens = math.min(capacity, tokens + math.max(0, now - ts) * rate)
+
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', now)
+redis.call('PEXPIRE', KEYS[1], window)
+
+local retry = 0
+if tokens < 1 then
+	retry = math.ceil((1 - tokens) / rate)
+end
+return {allowed, math.floor(tokens), math.ceil((capacity - tokens) / rate), retry}
+`)
+
+var slidingLogScript = redis.NewScript(`
+local limit = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = t[1] * 1000 + math.floor(t[2] / 1000)
+
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+local count = redis.call('ZCARD', KEYS[1])
+
+local allowed = 0
+if count < limit then
+	redis.call('ZADD', KEYS[1], now, ARGV[3])
+	count = count + 1
+	allowed = 1
+end
+redis.call('PEXPIRE', KEYS[1], window)
+
+local reset = 0
+local retry = 0
+local newest = redis.call('ZRANGE', KEYS[1], -1, -1, 'WITHSCORES')
+if newest[2] then
+	reset = tonumber(newest[2]) + window - now
+end
+if count >= limit then
+	local oldest = redis.call('ZRANGE', KEYS[1], 0, 0, 'WITHSCORES')
+	retry = tonumber(oldest[2]) + window - now
+end
+return {allowed, limit - count, reset, retry}
+`)
+
+type scriptLimiter struct {
+	rdb    *redis.Client
+	script *redis.Script
+	// member adds a unique argument for scripts that need one
+	member bool
+}
+
+func NewTokenBucket(rdb *redis.Client) Limiter {
+	return &scriptLimiter{rdb: rdb, script: tokenBucketScript}
+}
+
+func NewSlidingLog(rdb *redis.Client) Limiter {
+	return &scriptLimiter{rdb: rdb, script: slidingLogScript, member: true}
+}
+
+func (l *scriptLimiter) Allow(ctx context.Context, key string, p Policy) (Result, error) {
+	const op = "scriptLimiter.Allow"
+
+	args := []any{p.Limit, p.Window.Milliseconds()}
+	if l.member {
+		args = append(args, uuid.NewString())
+	}
+
+	vals, err := l.script.Run(ctx, l.rdb, []string{key}, args...).Int64Slice()
+	if err != nil {
+		return Result{}, fmt.Errorf("%s: run script: %w", op, err)
+	}
+	if len(vals) != 4 {
+		return Result{}, fmt.Errorf("%s: parse result: %s", op, "Unexpected script reply")
+	}
+
+	return Result{
+		Allowed:    vals[0] == 1,
+		Limit:      p.Limit,
+		Remaining:  max(vals[1], 0),
+		Reset:      time.Duration(vals[2]) * time.Millisecond,
+		RetryAfter: time.Duration(vals[3]) * time.Millisecond,
+	}, nil
+}
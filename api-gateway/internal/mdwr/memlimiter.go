@@ -0,0 +1,138 @@
+package mdwr
+
+import (
+	"context"
+	"hash/fnv"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	memShards        = 32
+	memEvictInterval = time.Minute
+)
+
+// memLimiter keeps the same budgets as the Redis scripts in process. It
+// stands in while Redis is unreachable, so its counts are per gateway
+// instance.
+type memLimiter struct {
+	shards [memShards]memShard
+}
+
+type memShard struct {
+	mu      sync.Mutex
+	entries map[string]*memEntry
+}
+
+type memEntry struct {
+	// token bucket
+	tokens float64
+	ts     time.Time
+	// sliding log
+	log []time.Time
+
+	expires time.Time
+}
+
+func newMemLimiter() *memLimiter {
+	l := &memLimiter{}
+	for i := range l.shards {
+		l.shards[i].entries = map[string]*memEntry{}
+	}
+	return l
+}
+
+func (l *memLimiter) shard(key string) *memShard {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return &l.shards[h.Sum32()%memShards]
+}
+
+func (l *memLimiter) Allow(ctx context.Context, key string, p Policy) (Result, error) {
+	s := l.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	e, ok := s.entries[key]
+	if !ok || now.After(e.expires) {
+		e = &memEntry{tokens: float64(p.Limit), ts: now}
+		s.entries[key] = e
+	}
+	e.expires = now.Add(p.Window)
+
+	if p.Algorithm == TokenBucket {
+		return e.takeToken(now, p), nil
+	}
+	return e.logRequest(now, p), nil
+}
+
+func (e *memEntry) takeToken(now time.Time, p Policy) Result {
+	capacity := float64(p.Limit)
+	// tokens per nanosecond
+	rate := capacity / float64(p.Window)
+
+	e.tokens = math.Min(capacity, e.tokens+float64(now.Sub(e.ts))*rate)
+	e.ts = now
+
+	res := Result{Limit: p.Limit}
+	if e.tokens >= 1 {
+		e.tokens--
+		res.Allowed = true
+	}
+	if e.tokens < 1 {
+		res.RetryAfter = time.Duration(math.Ceil((1 - e.tokens) / rate))
+	}
+	res.Remaining = int64(e.tokens)
+	res.Reset = time.Duration(math.Ceil((capacity - e.tokens) / rate))
+	return res
+}
+
+func (e *memEntry) logRequest(now time.Time, p Policy) Result {
+	cut := 0
+	for cut < len(e.log) && !e.log[cut].After(now.Add(-p.Window)) {
+		cut++
+	}
+	e.log = e.log[cut:]
+
+	res := Result{Limit: p.Limit}
+	if int64(len(e.log)) < p.Limit {
+		e.log = append(e.log, now)
+		res.Allowed = true
+	}
+
+	res.Remaining = p.Limit - int64(len(e.log))
+	if len(e.log) > 0 {
+		res.Reset = e.log[len(e.log)-1].Add(p.Window).Sub(now)
+	}
+	if res.Remaining == 0 {
+		res.RetryAfter = e.log[0].Add(p.Window).Sub(now)
+	}
+	return res
+}
+
+// evict drops budgets that have not been touched for their window until
+// ctx is done.
+func (l *memLimiter) evict(ctx context.Context) {
+	ticker := time.NewTicker(memEvictInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			for i := range l.shards {
+				s := &l.shards[i]
+				s.mu.Lock()
+				for key, e := range s.entries {
+					if now.After(e.expires) {
+						delete(s.entries, key)
+					}
+				}
+				s.mu.Unlock()
+			}
+		}
+	}
+}
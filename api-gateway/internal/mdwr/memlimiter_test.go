@@ -0,0 +1,86 @@
+package mdwr
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+var t0 = time.Unix(1_700_000_000, 0)
+
+func TestMemTokenBucket(t *testing.T) {
+	p := Policy{Algorithm: TokenBucket, Limit: 3, Window: 3 * time.Second}
+	e := &memEntry{tokens: 3, ts: t0}
+
+	steps := []struct {
+		at            time.Duration
+		wantAllowed   bool
+		wantRemaining int64
+		wantRetry     time.Duration
+	}{
+		{0, true, 2, 0},
+		{0, true, 1, 0},
+		{0, true, 0, time.Second},
+		{0, false, 0, time.Second},
+		// one token back per second
+		{1500 * time.Millisecond, true, 0, 500 * time.Millisecond},
+		{10 * time.Second, true, 2, 0},
+	}
+	for i, s := range steps {
+		res := e.takeToken(t0.Add(s.at), p)
+		if res.Allowed != s.wantAllowed || res.Remaining != s.wantRemaining || res.RetryAfter != s.wantRetry {
+			t.Errorf("step %d: %+v, want allowed %v remaining %d retry %s",
+				i, res, s.wantAllowed, s.wantRemaining, s.wantRetry)
+		}
+	}
+}
+
+func TestMemSlidingLog(t *testing.T) {
+	p := Policy{Algorithm: SlidingLog, Limit: 2, Window: 10 * time.Second}
+	e := &memEntry{}
+
+	steps := []struct {
+		at            time.Duration
+		wantAllowed   bool
+		wantRemaining int64
+		wantRetry     time.Duration
+	}{
+		{0, true, 1, 0},
+		{4 * time.Second, true, 0, 6 * time.Second},
+		{5 * time.Second, false, 0, 5 * time.Second},
+		// the first request leaves the window exactly at 10s
+		{10 * time.Second, true, 0, 4 * time.Second},
+		{25 * time.Second, true, 1, 0},
+	}
+	for i, s := range steps {
+		res := e.logRequest(t0.Add(s.at), p)
+		if res.Allowed != s.wantAllowed || res.Remaining != s.wantRemaining || res.RetryAfter != s.wantRetry {
+			t.Errorf("step %d: %+v, want allowed %v remaining %d retry %s",
+				i, res, s.wantAllowed, s.wantRemaining, s.wantRetry)
+		}
+	}
+}
+
+func TestMemLimiterKeys(t *testing.T) {
+	l := newMemLimiter()
+	ctx := context.Background()
+
+	for _, algo := range []string{TokenBucket, SlidingLog} {
+		t.Run(algo, func(t *testing.T) {
+			p := Policy{Algorithm: algo, Limit: 2, Window: time.Hour}
+			a, b := "a:"+algo, "b:"+algo
+
+			for i := 0; i < 2; i++ {
+				if res, _ := l.Allow(ctx, a, p); !res.Allowed {
+					t.Fatalf("request %d of %s refused", i, a)
+				}
+			}
+			if res, _ := l.Allow(ctx, a, p); res.Allowed {
+				t.Errorf("%s allowed past its limit", a)
+			}
+			if res, _ := l.Allow(ctx, b, p); !res.Allowed || res.Remaining != 1 {
+				t.Errorf("%s shares the budget of %s: %+v", b, a, res)
+			}
+		})
+	}
+}
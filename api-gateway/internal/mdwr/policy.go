@@ -0,0 +1,145 @@
+package mdwr
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	TokenBucket = "token_bucket"
+	SlidingLog  = "sliding_log"
+)
+
+// Policy is a budget of Limit requests per Window. A token bucket
+// refills continuously and allows bursts up to Limit, a sliding log
+// counts exactly the requests of the last Window.
+type Policy struct {
+	Algorithm string
+	Limit     int64
+	Window    time.Duration
+}
+
+// defaultPolicy matches the old fixed window per IP.
+var defaultPolicy = Policy{Algorithm: SlidingLog, Limit: 50, Window: time.Minute}
+
+// Policies hold per IP limits per route group and limits per caller.
+// Lookups fall back from the specific entry to the general one.
+type Policies struct {
+	Default Policy
+	Groups  map[string]Policy
+	// User applies to every authenticated user without an own entry
+	User  *Policy
+	Users map[string]Policy
+	Keys  map[string]Policy
+}
+
+// LoadPolicies reads RL_POLICIES, a comma separated list of
+// scope=algorithm:limit/window entries, e.g.
+//
+//	default=sliding_log:50/1m,group:orders=token_bucket:20/1m,
+//	user=token_bucket:120/1m,user:<id>=token_bucket:600/1m,key:<id>=sliding_log:10/1s
+func LoadPolicies() (*Policies, error) {
+	ps := &Policies{
+		Default: defaultPolicy,
+		Groups:  map[string]Policy{},
+		Users:   map[string]Policy{},
+		Keys:    map[string]Policy{},
+	}
+
+	spec := strings.TrimSpace(os.Getenv("RL_POLICIES"))
+	if spec == "" {
+		return ps, nil
+	}
+
+	for _, entry := range strings.Split(spec, ",") {
+		scope, value, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok {
+			return nil, fmt.Errorf("policy %q: missing '='", entry)
+		}
+		p, err := parsePolicy(value)
+		if err != nil {
+			return nil, fmt.Errorf("policy %q: %w", entry, err)
+		}
+
+		kind, name, _ := strings.Cut(scope, ":")
+		switch {
+		case kind == "default" && name == "":
+			ps.Default = p
+		case kind == "user" && name == "":
+			ps.User = &p
+		case kind == "group" && name != "":
+			ps.Groups[name] = p
+		case kind == "user":
+			ps.Users[name] = p
+		case kind == "key" && name != "":
+			ps.Keys[name] = p
+		default:
+			return nil, fmt.Errorf("policy %q: unknown scope %q", entry, scope)
+		}
+	}
+
+	return ps, nil
+}
+
+func parsePolicy(s string) (Policy, error) {
+	algo, rate, ok := strings.Cut(s, ":")
+	if !ok {
+		return Policy{}, errors.New("expected algorithm:limit/window")
+	}
+	if algo != TokenBucket && algo != SlidingLog {
+		return Policy{}, fmt.Errorf("unknown algorithm %q", algo)
+	}
+
+	limit, window, ok := strings.Cut(rate, "/")
+	if !ok {
+		return Policy{}, errors.New("expected limit/window")
+	}
+	n, err := strconv.ParseInt(limit, 10, 64)
+	if err != nil || n <= 0 {
+		return Policy{}, fmt.Errorf("invalid limit %q", limit)
+	}
+	d, err := time.ParseDuration(window)
+	if err != nil || d < time.Millisecond {
+		return Policy{}, fmt.Errorf("invalid window %q", window)
+	}
+
+	return Policy{Algorithm: algo, Limit: n, Window: d}, nil
+}
+
+// ForGroup is the per IP policy of a route group and the budget it
+// counts against. Groups without an entry share the default budget.
+func (ps *Policies) ForGroup(group string) (Policy, string) {
+	if p, ok := ps.Groups[group]; ok {
+		return p, group
+	}
+	return ps.Default, "default"
+}
+
+// ForCaller is the policy of an authenticated caller and the budget it
+// counts against. API keys use their own per-minute budget unless
+// configured here, keys without one share their user's. Callers without
+// any policy are not limited beyond their IP.
+func (ps *Policies) ForCaller(userID, keyID string, keyRate int64) (Policy, string, bool) {
+	if keyID != "" {
+		if p, ok := ps.Keys[keyID]; ok {
+			return p, "key:" + keyID, true
+		}
+		if keyRate > 0 {
+			return Policy{Algorithm: ps.Default.Algorithm, Limit: keyRate, Window: time.Minute}, "key:" + keyID, true
+		}
+	}
+	if userID == "" {
+		return Policy{}, "", false
+	}
+	if p, ok := ps.Users[userID]; ok {
+		return p, "user:" + userID, true
+	}
+	if ps.User != nil {
+		return *ps.User, "user:" + userID, true
+	}
+	return Policy{}, "", false
+}
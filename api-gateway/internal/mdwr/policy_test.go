@@ -0,0 +1,95 @@
+package mdwr
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLoadPolicies(t *testing.T) {
+	tb := func(n int64, d time.Duration) Policy { return Policy{Algorithm: TokenBucket, Limit: n, Window: d} }
+	sl := func(n int64, d time.Duration) Policy { return Policy{Algorithm: SlidingLog, Limit: n, Window: d} }
+
+	tests := []struct {
+		name    string
+		env     string
+		want    *Policies
+		wantErr bool
+	}{
+		{"unset", "", &Policies{
+			Default: defaultPolicy,
+			Groups:  map[string]Policy{},
+			Users:   map[string]Policy{},
+			Keys:    map[string]Policy{},
+		}, false},
+		{"every scope", "default=token_bucket:100/1m, group:orders=sliding_log:20/30s," +
+			"user=token_bucket:120/1m,user:u1=token_bucket:600/1m,key:k1=sliding_log:10/1s", &Policies{
+			Default: tb(100, time.Minute),
+			Groups:  map[string]Policy{"orders": sl(20, 30*time.Second)},
+			User:    &Policy{Algorithm: TokenBucket, Limit: 120, Window: time.Minute},
+			Users:   map[string]Policy{"u1": tb(600, time.Minute)},
+			Keys:    map[string]Policy{"k1": sl(10, time.Second)},
+		}, false},
+		{"missing =", "default", nil, true},
+		{"unknown scope", "ip=sliding_log:1/1s", nil, true},
+		{"group without name", "group=sliding_log:1/1s", nil, true},
+		{"unknown algorithm", "default=leaky_bucket:1/1s", nil, true},
+		{"missing algorithm", "default=1/1s", nil, true},
+		{"missing window", "default=sliding_log:1", nil, true},
+		{"zero limit", "default=sliding_log:0/1s", nil, true},
+		{"bad window", "default=sliding_log:1/soon", nil, true},
+		{"window below 1ms", "default=sliding_log:1/10us", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("RL_POLICIES", tt.env)
+			got, err := LoadPolicies()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LoadPolicies = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolicyLookup(t *testing.T) {
+	t.Setenv("RL_POLICIES", "group:orders=token_bucket:20/1m,user=sliding_log:120/1m,"+
+		"user:vip=sliding_log:600/1m,key:k1=sliding_log:10/1s")
+	ps, err := LoadPolicies()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p, budget := ps.ForGroup("orders"); budget != "orders" || p.Limit != 20 {
+		t.Errorf("ForGroup(orders) = %+v, %s", p, budget)
+	}
+	if p, budget := ps.ForGroup("users"); budget != "default" || p != defaultPolicy {
+		t.Errorf("ForGroup(users) = %+v, %s", p, budget)
+	}
+
+	tests := []struct {
+		name       string
+		user, key  string
+		keyRate    int64
+		wantLimit  int64
+		wantBudget string
+		wantOK     bool
+	}{
+		{"configured key", "u1", "k1", 5, 10, "key:k1", true},
+		{"key with own rate", "u1", "k2", 5, 5, "key:k2", true},
+		{"key without rate shares user", "u1", "k3", 0, 120, "user:u1", true},
+		{"configured user", "vip", "", 0, 600, "user:vip", true},
+		{"any user", "u1", "", 0, 120, "user:u1", true},
+		{"anonymous", "", "", 0, 0, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, budget, ok := ps.ForCaller(tt.user, tt.key, tt.keyRate)
+			if ok != tt.wantOK || budget != tt.wantBudget || p.Limit != tt.wantLimit {
+				t.Errorf("ForCaller = %+v, %q, %v", p, budget, ok)
+			}
+		})
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"strings"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
+	"gateway/internal/clientip"
 	ck "gateway/internal/contextKeys"
 )
 
@@ -72,6 +74,12 @@ func (m *mdwr) RequestID() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			reqID := uuid.NewString()
+			m.log.Info("Incoming request",
+				zap.String("request id", reqID),
+				zap.String("method", r.Method),
+				zap.String("path", r.URL.Path),
+				zap.String("client ip", clientip.FromContext(r.Context()).String()))
+
 			ctx := context.WithValue(r.Context(), ck.ReqKey, reqID)
 			r.Header.Set(RequestIDHeader, reqID)
 			next.ServeHTTP(w, r.WithContext(ctx))
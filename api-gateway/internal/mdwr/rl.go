@@ -4,61 +4,165 @@ import (
 	"context"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-redis/redis/v8"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/zap"
+
+	"gateway/internal/clientip"
+	ck "gateway/internal/contextKeys"
+	"gateway/internal/service"
+
+	"github.com/Votline/3l1/protos/apierr"
 )
 
-const exp = time.Minute
+// redisCheckInterval is how often the connection to Redis is probed,
+// both to notice an outage and to come back after one.
+const redisCheckInterval = 5 * time.Second
+
+var rlMode = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "gateway_rate_limit_mode",
+	Help: "Active rate limiter backend, 1 for the one in use",
+}, []string{"mode"})
 
 type rateLimiter struct {
-	maxRate int64
+	policies *Policies
+	limiters map[string]Limiter
+	// local takes over while Redis is down
+	local   *memLimiter
+	redisUp atomic.Bool
 	log     *zap.Logger
 	rdb     *redis.Client
 	ctx     context.Context
+	stop    context.CancelFunc
 }
 
+// NewRl starts limiting locally when Redis cannot be reached and
+// switches over once it can.
 func NewRl(log *zap.Logger) *rateLimiter {
 	rdb := redis.NewClient(&redis.Options{
-		Addr:     os.Getenv("REDIS_RL_HOST") + ":6379",
-		Password: os.Getenv("REDIS_RL_PSWD"),
-		DB:       0,
+		Addr:         os.Getenv("REDIS_RL_HOST") + ":6379",
+		Password:     os.Getenv("REDIS_RL_PSWD"),
+		DB:           0,
+		DialTimeout:  time.Second,
+		ReadTimeout:  500 * time.Millisecond,
+		WriteTimeout: 500 * time.Millisecond,
+		MaxRetries:   1,
 	})
 
-	ctx := context.Background()
-	if err := rdb.Ping(ctx).Err(); err != nil {
-		log.Fatal("Failed connect to rl redis", zap.Error(err))
+	policies, err := LoadPolicies()
+	if err != nil {
+		log.Fatal("Failed to load rate limit policies", zap.Error(err))
 	}
 
-	return &rateLimiter{
-		rdb:     rdb,
-		ctx:     ctx,
-		log:     log,
-		maxRate: 50,
+	ctx, stop := context.WithCancel(context.Background())
+	rl := &rateLimiter{
+		policies: policies,
+		limiters: map[string]Limiter{
+			TokenBucket: NewTokenBucket(rdb),
+			SlidingLog:  NewSlidingLog(rdb),
+		},
+		local: newMemLimiter(),
+		rdb:   rdb,
+		ctx:   ctx,
+		stop:  stop,
+		log:   log,
 	}
+
+	err = rl.ping()
+	if err != nil {
+		log.Error("Failed connect to rl redis, limiting locally", zap.Error(err))
+	}
+	rl.setMode(err == nil)
+	go rl.local.evict(ctx)
+	go rl.watch()
+
+	return rl
 }
 
+func (rl *rateLimiter) Close() error {
+	rl.stop()
+	return rl.rdb.Close()
+}
+
+func (rl *rateLimiter) ping() error {
+	ctx, cancel := context.WithTimeout(rl.ctx, time.Second)
+	defer cancel()
+	return rl.rdb.Ping(ctx).Err()
+}
+
+func (rl *rateLimiter) watch() {
+	ticker := time.NewTicker(redisCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-rl.ctx.Done():
+			return
+		case <-ticker.C:
+			err := rl.ping()
+			if err != nil && rl.redisUp.Load() {
+				rl.log.Error("Lost rl redis", zap.Error(err))
+			}
+			rl.setMode(err == nil)
+		}
+	}
+}
+
+// setMode switches between Redis and the local limiter, logging only
+// actual changes.
+func (rl *rateLimiter) setMode(up bool) {
+	if rl.redisUp.Swap(up) != up {
+		mode := "local"
+		if up {
+			mode = "redis"
+		}
+		rl.log.Warn("Rate limiter switched", zap.String("mode", mode))
+	}
+
+	if up {
+		rlMode.WithLabelValues("redis").Set(1)
+		rlMode.WithLabelValues("local").Set(0)
+	} else {
+		rlMode.WithLabelValues("redis").Set(0)
+		rlMode.WithLabelValues("local").Set(1)
+	}
+}
+
+// Middleware limits every client IP by the policy of the route group
+// it calls, before anything else runs.
 func (rl *rateLimiter) Middleware() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			ip := strings.Split(r.RemoteAddr, ":")[0]
-			key := "rl:" + ip
-
-			count, err := rl.rdb.Incr(rl.ctx, key).Result()
-			if err != nil {
-				rl.log.Error("Failed to increment by key", zap.Error(err))
-				next.ServeHTTP(w, r)
+			ip := clientip.Bucket(clientip.FromContext(r.Context()))
+			p, scope := rl.policies.ForGroup(routeGroup(r.URL.Path))
+			if !rl.allow(w, r, "rl:ip:"+scope+":"+ip, p) {
 				return
 			}
 
-			if count == 1 {
-				rl.rdb.Expire(rl.ctx, key, exp)
-			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// CallerLimit applies the policy of the authenticated user or API key.
+// It runs after JWTAuth, callers without a policy pass untouched.
+func (rl *rateLimiter) CallerLimit() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
 
-			if count > rl.maxRate {
-				http.Error(w, "Too many requests", http.StatusTooManyRequests)
+			p, scope, ok := rl.policies.ForCaller(ui.UserID, ui.KeyID, ui.RateLimit)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if !rl.allow(w, r, "rl:"+scope, p) {
 				return
 			}
 
@@ -66,3 +170,55 @@ func (rl *rateLimiter) Middleware() func(http.Handler) http.Handler {
 		})
 	}
 }
+
+// allow checks key under p and writes the RateLimit headers. A failed
+// Redis call falls back to the local limiter until the watcher sees
+// Redis answer again.
+func (rl *rateLimiter) allow(w http.ResponseWriter, r *http.Request, key string, p Policy) bool {
+	var limiter Limiter = rl.local
+	if rl.redisUp.Load() {
+		limiter = rl.limiters[p.Algorithm]
+	}
+
+	res, err := limiter.Allow(rl.ctx, key, p)
+	if err != nil {
+		rl.log.Error("Failed to check rate limit, limiting locally",
+			zap.String("key", key),
+			zap.Error(err))
+		rl.setMode(false)
+		if res, err = rl.local.Allow(rl.ctx, key, p); err != nil {
+			return true
+		}
+	}
+
+	h := w.Header()
+	h.Set("RateLimit-Limit", strconv.FormatInt(res.Limit, 10))
+	h.Set("RateLimit-Remaining", strconv.FormatInt(res.Remaining, 10))
+	h.Set("RateLimit-Reset", strconv.FormatInt(seconds(res.Reset), 10))
+
+	if !res.Allowed {
+		rl.log.Warn("Rate limit exceeded",
+			zap.String("key", key),
+			zap.String("algorithm", p.Algorithm),
+			zap.Int64("limit", p.Limit),
+			zap.Duration("window", p.Window))
+		h.Set("Retry-After", strconv.FormatInt(seconds(res.RetryAfter), 10))
+		service.NewContext(w, r).Problem(apierr.Limited("Too many requests"))
+		return false
+	}
+	return true
+}
+
+// routeGroup is the service name of /api/<group>/..., "root" otherwise.
+func routeGroup(path string) string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) >= 2 && parts[0] == "api" {
+		return parts[1]
+	}
+	return "root"
+}
+
+// seconds rounds up, a client waiting for 0 would retry too early.
+func seconds(d time.Duration) int64 {
+	return int64((d + time.Second - 1) / time.Second)
+}
@@ -1,7 +1,6 @@
 package orders
 
 import (
-	"context"
 	"net/http"
 
 	"github.com/go-chi/chi"
@@ -10,6 +9,7 @@ import (
 	ck "gateway/internal/contextKeys"
 	"gateway/internal/service"
 
+	"github.com/Votline/3l1/protos/apierr"
 	pb "github.com/Votline/3l1/protos/generated-order"
 )
 
@@ -35,12 +35,16 @@ func (oc *ordersClient) addOrder(w http.ResponseWriter, r *http.Request) {
 			zap.String("op", op),
 			zap.String("request id", rq),
 			zap.Error(err))
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		c.Problem(err)
 		return
 	}
 	ui, ok := r.Context().Value(ck.UserKey).(ck.UserInfo)
 	if !ok {
-		http.Error(w, "user not authorized", http.StatusUnauthorized)
+		c.Problem(apierr.Unauthenticated("user not authorized"))
+		return
+	}
+	if !ui.Verified {
+		c.Problem(apierr.PermissionDenied("email is not verified").WithReason(apierr.ReasonEmailNotVerified))
 		return
 	}
 	req.userID = ui.UserID
@@ -63,12 +67,13 @@ func (oc *ordersClient) addOrder(w http.ResponseWriter, r *http.Request) {
 
 	res, err := service.Execute(oc.cb, func() (*pb.AddOrderRes, error) {
 		return oc.client.AddOrder(c.Context(), &pb.AddOrderReq{
-			UserId:     req.userID,
-			TargetUrl:  req.TargetURL,
-			ServiceUrl: req.ServiceURL,
-			OrderType:  req.OrderType,
-			Quantity:   req.Quantity,
-			RequestId:  rq,
+			UserId:      req.userID,
+			TargetUrl:   req.TargetURL,
+			ServiceUrl:  req.ServiceURL,
+			OrderType:   req.OrderType,
+			Quantity:    req.Quantity,
+			RequestId:   rq,
+			Permissions: ui.Perms,
 		})
 	})
 	if err != nil {
@@ -76,7 +81,7 @@ func (oc *ordersClient) addOrder(w http.ResponseWriter, r *http.Request) {
 			zap.String("op", op),
 			zap.String("request id", rq),
 			zap.Error(err))
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		c.Problem(err)
 		return
 	}
 
@@ -100,8 +105,13 @@ func (oc *ordersClient) orderInfo(w http.ResponseWriter, r *http.Request) {
 		userID string `validate:"required,len=36"`
 	}{}
 
+	ui, ok := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	if !ok {
+		c.Problem(apierr.Unauthenticated("user not authorized"))
+		return
+	}
 	req.id = chi.URLParam(r, "orderID")
-	req.userID = r.Context().Value("userInfo").(ck.UserInfo).UserID
+	req.userID = ui.UserID
 	rq := r.Context().Value(ck.ReqKey).(string)
 
 	if err := c.Validate(req); err != nil {
@@ -120,9 +130,10 @@ func (oc *ordersClient) orderInfo(w http.ResponseWriter, r *http.Request) {
 
 	res, err := service.Execute(oc.cb, func() (*pb.OrderInfoRes, error) {
 		return oc.client.OrderInfo(c.Context(), &pb.OrderInfoReq{
-			Id:        req.id,
-			UserId:    req.userID,
-			RequestId: rq,
+			Id:          req.id,
+			UserId:      req.userID,
+			RequestId:   rq,
+			Permissions: ui.Perms,
 		})
 	})
 	if err != nil {
@@ -130,7 +141,7 @@ func (oc *ordersClient) orderInfo(w http.ResponseWriter, r *http.Request) {
 			zap.String("op", op),
 			zap.String("request id", rq),
 			zap.Error(err))
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		c.Problem(err)
 		return
 	}
 
@@ -157,13 +168,18 @@ func (oc *ordersClient) delOrder(w http.ResponseWriter, r *http.Request) {
 	c := service.NewContext(w, r)
 	req := struct {
 		id     string `validate:"required,len=36"`
-		role   string `validate:"oneof=admin user guest dev"`
+		role   string
 		userID string `validate:"required,len=36"`
 	}{}
 
+	ui, ok := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	if !ok {
+		c.Problem(apierr.Unauthenticated("user not authorized"))
+		return
+	}
 	req.id = chi.URLParam(r, "orderID")
-	req.role = r.Context().Value("userInfo").(ck.UserInfo).Role
-	req.userID = r.Context().Value("userInfo").(ck.UserInfo).UserID
+	req.role = ui.Role
+	req.userID = ui.UserID
 	rq := r.Context().Value(ck.ReqKey).(string)
 
 	if err := c.Validate(req); err != nil {
@@ -171,7 +187,6 @@ func (oc *ordersClient) delOrder(w http.ResponseWriter, r *http.Request) {
 			zap.String("op", op),
 			zap.String("request id", rq),
 			zap.Error(err))
-		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
@@ -183,18 +198,19 @@ func (oc *ordersClient) delOrder(w http.ResponseWriter, r *http.Request) {
 		zap.String("order id", req.id))
 
 	if _, err := service.Execute(oc.cb, func() (*pb.DelOrderRes, error) {
-		return oc.client.DelOrder(context.Background(), &pb.DelOrderReq{
-			Id:        req.id,
-			Role:      req.role,
-			UserId:    req.userID,
-			RequestId: rq,
+		return oc.client.DelOrder(c.Context(), &pb.DelOrderReq{
+			Id:          req.id,
+			Role:        req.role,
+			UserId:      req.userID,
+			RequestId:   rq,
+			Permissions: ui.Perms,
 		})
 	}); err != nil {
 		oc.log.Error("Rpc request failed",
 			zap.String("op", op),
 			zap.String("request id", rq),
 			zap.Error(err))
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		c.Problem(err)
 		return
 	}
 
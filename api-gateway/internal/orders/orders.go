@@ -7,14 +7,15 @@ import (
 	"github.com/go-chi/chi"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
-	"github.com/sony/gobreaker/v2"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
 	"gateway/internal/cbreaker"
 	gc "gateway/internal/graceful"
+	"gateway/internal/mdwr"
 	"gateway/internal/service"
 
+	"github.com/Votline/3l1/protos/authz"
 	pb "github.com/Votline/3l1/protos/generated-order"
 )
 
@@ -26,13 +27,22 @@ type ordersClient struct {
 	hist    *prometheus.HistogramVec
 	counter *prometheus.CounterVec
 	active  prometheus.Gauge
-	cb      *gobreaker.CircuitBreaker[any]
+	cb      *cbreaker.Breaker
+}
+
+// retryPolicies lists the calls that are safe to repeat. AddOrder and
+// DelOrder are not: a retried add is a second order, a retried delete
+// reports a missing order after the first one went through.
+var retryPolicies = map[string]service.RetryPolicy{
+	"OrderInfo": service.Idempotent,
 }
 
 func New(resTime *prometheus.HistogramVec, log *zap.Logger) service.Service {
+	retrier := service.NewRetrier("orders", retryPolicies, log)
 	conn, err := grpc.NewClient(
 		os.Getenv("OS_HOST")+":"+os.Getenv("OS_PORT"),
-		grpc.WithInsecure())
+		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(retrier.Interceptor()))
 	if err != nil {
 		log.Fatal("Order-service connection failed")
 	}
@@ -44,12 +54,12 @@ func New(resTime *prometheus.HistogramVec, log *zap.Logger) service.Service {
 		hist:    resTime,
 		counter: newCounter(),
 		active:  newGauge(),
-		cb:      cbreaker.NewCb("OrderService", log),
+		cb:      cbreaker.NewCb("orders", log),
 	}
 }
 
 func (os *ordersClient) RegisterRoutes(g chi.Router) {
-	g.Post("/", os.addOrder)
+	g.With(mdwr.Require(authz.OrdersCreate)).Post("/", os.addOrder)
 	g.Get("/{orderID}", os.orderInfo)
 	g.Delete("/del/{orderID}", os.delOrder)
 }
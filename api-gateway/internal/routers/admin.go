@@ -0,0 +1,99 @@
+package routers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+
+	"gateway/internal/cbreaker"
+	ck "gateway/internal/contextKeys"
+	"gateway/internal/mdwr"
+	"gateway/internal/service"
+
+	"github.com/Votline/3l1/protos/apierr"
+	"github.com/Votline/3l1/protos/authz"
+)
+
+// adminRoutes are the gateway's own endpoints, not proxied anywhere.
+func (s *Server) adminRoutes(g chi.Router) {
+	g.With(mdwr.Require(authz.GatewayManage)).Get("/breakers", s.listBreakers)
+	g.With(mdwr.Require(authz.GatewayManage)).Get("/breakers/{name}", s.getBreaker)
+	g.With(mdwr.Require(authz.GatewayManage)).Put("/breakers/{name}", s.forceBreaker)
+}
+
+func (s *Server) listBreakers(w http.ResponseWriter, r *http.Request) {
+	c := service.NewContext(w, r)
+
+	all := cbreaker.All()
+	out := make([]cbreaker.Status, len(all))
+	for i, b := range all {
+		out[i] = b.Status()
+	}
+	c.JSON(http.StatusOK, map[string]any{"breakers": out})
+}
+
+func (s *Server) getBreaker(w http.ResponseWriter, r *http.Request) {
+	c := service.NewContext(w, r)
+
+	b, ok := cbreaker.Get(chi.URLParam(r, "name"))
+	if !ok {
+		c.Problem(apierr.NotFound("unknown breaker"))
+		return
+	}
+	c.JSON(http.StatusOK, b.Status())
+}
+
+func (s *Server) forceBreaker(w http.ResponseWriter, r *http.Request) {
+	const op = "routers.forceBreaker"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		Mode   string `json:"mode"   validate:"oneof=auto open closed"`
+		Reason string `json:"reason" validate:"required,min=3,max=500"`
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	s.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	if err := c.Bind(&req); err != nil {
+		s.log.Error("Failed to bind request",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+	if err := c.Validate(req); err != nil {
+		s.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	b, ok := cbreaker.Get(chi.URLParam(r, "name"))
+	if !ok {
+		c.Problem(apierr.NotFound("unknown breaker"))
+		return
+	}
+	if err := b.Force(req.Mode); err != nil {
+		c.Problem(apierr.Invalid(err.Error()))
+		return
+	}
+
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	s.log.Warn("Breaker mode set by operator",
+		zap.String("request id", rq),
+		zap.String("breaker", b.Name()),
+		zap.String("mode", req.Mode),
+		zap.String("user id", ui.UserID),
+		zap.String("reason", req.Reason))
+
+	c.JSON(http.StatusOK, b.Status())
+}
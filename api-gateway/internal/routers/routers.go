@@ -16,6 +16,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
 
+	"gateway/internal/clientip"
 	"gateway/internal/mdwr"
 	"gateway/internal/orders"
 	"gateway/internal/service"
@@ -34,9 +35,11 @@ const (
 )
 
 type Server struct {
-	log  *zap.Logger
-	Srv  *http.Server
-	svcs []service.Service
+	log    *zap.Logger
+	Srv    *http.Server
+	svcs   []service.Service
+	admin  chi.Router
+	stopRl func() error
 }
 
 func NewServer(log *zap.Logger) *Server {
@@ -58,10 +61,17 @@ func NewServer(log *zap.Logger) *Server {
 		AllowedMethods:   corsMethods,
 	}
 
+	resolver, err := clientip.New()
+	if err != nil {
+		log.Fatal("Failed to load trusted proxies", zap.Error(err))
+	}
+	r.Use(resolver.Middleware())
+
 	rl := mdwr.NewRl(log)
+	s.stopRl = rl.Close
 	r.Use(rl.Middleware())
 
-	groups := s.activateMdwr()
+	groups := s.activateMdwr(rl.CallerLimit())
 	r.Use(cors.Handler(c))
 	r.Use(middleware.Recoverer)
 	r.Use(middleware.Compress(gzipLevel))
@@ -83,7 +93,7 @@ func NewServer(log *zap.Logger) *Server {
 	return &s
 }
 
-func (s *Server) activateMdwr() []chi.Router {
+func (s *Server) activateMdwr(callerLimit func(http.Handler) http.Handler) []chi.Router {
 	uc := users.New(resTime, s.log).(*users.UsersClient)
 	services := []service.Service{
 		uc,
@@ -94,16 +104,23 @@ func (s *Server) activateMdwr() []chi.Router {
 
 	for i, svc := range services {
 		g := chi.NewRouter()
-		m := mdwr.NewMdwr(svc, uc.ExtJWTData, s.log)
+		m := mdwr.NewMdwr(svc, uc.ExtJWTData, uc.AuthAPIKey, uc.AuthAccessToken, s.log)
 
 		g.Use(m.RequestID())
-		if svc.GetName() == "users" {
-			g.Use(m.JWTAuth())
-		}
+		g.Use(m.JWTAuth())
+		g.Use(callerLimit)
 		g.Use(m.Metrics())
 		groups[i] = g
 	}
 	s.svcs = services
+
+	// admin endpoints authenticate like the rest but belong to no service
+	s.admin = chi.NewRouter()
+	m := mdwr.NewMdwr(uc, uc.ExtJWTData, uc.AuthAPIKey, uc.AuthAccessToken, s.log)
+	s.admin.Use(m.RequestID())
+	s.admin.Use(m.JWTAuth())
+	s.admin.Use(callerLimit)
+
 	return groups
 }
 
@@ -119,12 +136,20 @@ func (s *Server) routing(r *chi.Mux, groups []chi.Router) {
 		})
 	}
 
+	r.Mount("/api/admin", s.admin)
+	s.admin.Route("/", s.adminRoutes)
+
 	r.Get("/", func(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte("root"))
 	})
 }
 
 func (s *Server) ShutdownServices(ctx context.Context) error {
+	s.log.Info("Shutting down rate limiter")
+	if err := s.stopRl(); err != nil {
+		s.log.Error("Rate limiter shutdown error", zap.Error(err))
+	}
+
 	var wg sync.WaitGroup
 	done := make(chan struct{})
 	for _, svc := range s.svcs {
@@ -0,0 +1,233 @@
+package routers
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	pb "github.com/Votline/3l1/protos/generated-user"
+)
+
+type fakeUsers struct {
+	pb.UnimplementedUserServiceServer
+	ips     chan string
+	updated chan string
+	sso     chan string
+}
+
+func (f *fakeUsers) LogUser(ctx context.Context, req *pb.LogReq) (*pb.LogRes, error) {
+	f.ips <- req.GetClientIp()
+	return &pb.LogRes{Token: "token", SessionKey: "00000000-0000-0000-0000-000000000000"}, nil
+}
+
+func (f *fakeUsers) AuthAPIKey(ctx context.Context, req *pb.AuthAPIKeyReq) (*pb.AuthAPIKeyRes, error) {
+	return &pb.AuthAPIKeyRes{
+		KeyId:       "key",
+		UserId:      "00000000-0000-0000-0000-000000000001",
+		Role:        "dev",
+		Permissions: []string{"*"},
+	}, nil
+}
+
+func (f *fakeUsers) UpdateUser(ctx context.Context, req *pb.UpdateUserReq) (*pb.UpdateUserRes, error) {
+	f.updated <- req.GetUserId()
+	return &pb.UpdateUserRes{User: &pb.UserProfile{}}, nil
+}
+
+var (
+	testOnce sync.Once
+	testSrv  *Server
+	testFake *fakeUsers
+)
+
+func (f *fakeUsers) SSOCallback(ctx context.Context, req *pb.SSOCallbackReq) (*pb.LogRes, error) {
+	f.sso <- req.GetState()
+	return &pb.LogRes{Token: "token", SessionKey: "00000000-0000-0000-0000-000000000000"}, nil
+}
+
+// testServer runs the gateway against a fake user-service. The metrics
+// are registered globally, so every test shares one server.
+func testServer(t *testing.T) (*Server, *fakeUsers) {
+	t.Helper()
+	testOnce.Do(func() {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		testFake = &fakeUsers{
+			ips:     make(chan string, 1),
+			updated: make(chan string, 1),
+			sso:     make(chan string, 1),
+		}
+		gs := grpc.NewServer()
+		pb.RegisterUserServiceServer(gs, testFake)
+		go gs.Serve(lis)
+
+		host, port, _ := net.SplitHostPort(lis.Addr().String())
+		os.Setenv("US_HOST", host)
+		os.Setenv("US_PORT", port)
+		os.Setenv("TRUSTED_PROXIES", "10.0.0.0/8")
+		// nothing listens there, the limiter falls back to memory
+		os.Setenv("REDIS_RL_HOST", "127.0.0.1")
+
+		testSrv = NewServer(zap.NewNop())
+	})
+	return testSrv, testFake
+}
+
+// The resolved address has to reach user-service through every
+// middleware of the router, the login throttling depends on it.
+func TestClientIPReachesService(t *testing.T) {
+	s, fake := testServer(t)
+
+	tests := []struct {
+		name   string
+		remote string
+		header string
+		value  string
+		want   string
+	}{
+		{"direct", "198.51.100.4:5000", "", "", "198.51.100.4"},
+		{"untrusted peer", "198.51.100.4:5000", "X-Forwarded-For", "203.0.113.7", "198.51.100.4"},
+		{"trusted proxy", "10.0.0.1:5000", "X-Forwarded-For", "203.0.113.7", "203.0.113.7"},
+		{"ipv6 bucket", "10.0.0.1:5000", "Forwarded", `for="[2001:db8:1:2:3::4]:80"`, "2001:db8:1:2::"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/users/log",
+				strings.NewReader(`{"name":"alice","password":"password123"}`))
+			req.RemoteAddr = tt.remote
+			if tt.header != "" {
+				req.Header.Set(tt.header, tt.value)
+			}
+			w := httptest.NewRecorder()
+			s.Srv.Handler.ServeHTTP(w, req)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("status = %d, body %s", w.Code, w.Body.String())
+			}
+			select {
+			case ip := <-fake.ips:
+				if ip != tt.want {
+					t.Errorf("client ip = %q, want %q", ip, tt.want)
+				}
+			default:
+				t.Fatal("LogUser was not called")
+			}
+		})
+	}
+}
+
+// An API key, even one holding every permission, must not change the
+// name or email the account signs in with.
+func TestDelegatedCannotUpdateProfile(t *testing.T) {
+	s, fake := testServer(t)
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/users/me",
+		strings.NewReader(`{"email":"mallory@example.com"}`))
+	req.Header.Set("Authorization", "ApiKey 3l1_key")
+	w := httptest.NewRecorder()
+	s.Srv.Handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, want %d, body %s", w.Code, http.StatusForbidden, w.Body.String())
+	}
+	select {
+	case id := <-fake.updated:
+		t.Fatalf("UpdateUser was called for %s", id)
+	default:
+	}
+}
+
+// An API key only carries the scopes it was granted, so it must not reach
+// account deletion through the owner's session cookie.
+func TestDelegatedCannotDeleteAccount(t *testing.T) {
+	s, _ := testServer(t)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/users/del/me", nil)
+	req.Header.Set("Authorization", "ApiKey 3l1_key")
+	req.AddCookie(&http.Cookie{Name: "session_key", Value: "00000000-0000-0000-0000-000000000002"})
+	w := httptest.NewRecorder()
+	s.Srv.Handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, want %d, body %s", w.Code, http.StatusForbidden, w.Body.String())
+	}
+}
+
+// A callback only completes in the browser that started the login,
+// otherwise an attacker could finish their own login in the victim's.
+func TestSSOCallbackNeedsStateCookie(t *testing.T) {
+	s, fake := testServer(t)
+	const state = "6f1c2a3e-0d4b-4c8e-9a7f-1b2c3d4e5f60"
+
+	tests := []struct {
+		name   string
+		cookie string
+		want   int
+	}{
+		{"no cookie", "", http.StatusUnauthorized},
+		{"other state", "0a1b2c3d-0000-4000-8000-000000000000", http.StatusUnauthorized},
+		{"same browser", state, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet,
+				"/api/users/sso/google/callback?code=abc&state="+state, nil)
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: "sso_state", Value: tt.cookie})
+			}
+			w := httptest.NewRecorder()
+			s.Srv.Handler.ServeHTTP(w, req)
+
+			if w.Code != tt.want {
+				t.Fatalf("status = %d, want %d, body %s", w.Code, tt.want, w.Body.String())
+			}
+			select {
+			case <-fake.sso:
+				if tt.want != http.StatusOK {
+					t.Error("SSOCallback was called")
+				}
+			default:
+				if tt.want == http.StatusOK {
+					t.Error("SSOCallback was not called")
+				}
+			}
+		})
+	}
+}
+
+// Ids taken from the path are checked before user-service sees them.
+func TestPathIDsAreValidated(t *testing.T) {
+	s, _ := testServer(t)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+	}{
+		{"unlock", http.MethodPost, "/api/users/unlock/not-a-uuid", ""},
+		{"set role", http.MethodPut, "/api/users/not-a-uuid/role", `{"role":"dev"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Authorization", "ApiKey 3l1_key")
+			w := httptest.NewRecorder()
+			s.Srv.Handler.ServeHTTP(w, req)
+
+			if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "VALIDATION_FAILED") {
+				t.Errorf("status = %d, body %s", w.Code, w.Body.String())
+			}
+		})
+	}
+}
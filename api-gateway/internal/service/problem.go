@@ -0,0 +1,136 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sony/gobreaker/v2"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ck "gateway/internal/contextKeys"
+
+	"github.com/Votline/3l1/protos/apierr"
+)
+
+// statusClientClosed is nginx's code for a client that went away.
+const statusClientClosed = 499
+
+// Problem is an RFC 7807 body. Code is the stable reason clients should
+// match on, Detail is for humans and may change.
+type Problem struct {
+	Type      string         `json:"type"`
+	Title     string         `json:"title"`
+	Status    int            `json:"status"`
+	Detail    string         `json:"detail,omitempty"`
+	Code      string         `json:"code"`
+	RequestID string         `json:"request_id,omitempty"`
+	Errors    []FieldProblem `json:"errors,omitempty"`
+	// sent as the Retry-After header
+	RetryAfter time.Duration `json:"-"`
+}
+
+// FieldProblem is one field that failed validation in the service.
+type FieldProblem struct {
+	Field  string `json:"field"`
+	Detail string `json:"detail"`
+}
+
+// HTTPStatus maps a gRPC code as grpc-gateway does.
+func HTTPStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return statusClientClosed
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
+// NewProblem translates a failed call. Details of server side failures
+// are left out, the handler logs them with the request id instead.
+func NewProblem(err error, requestID string) Problem {
+	st := toStatus(err)
+	code := HTTPStatus(st.Code())
+
+	p := Problem{
+		Type:      "about:blank",
+		Title:     http.StatusText(code),
+		Status:    code,
+		Code:      apierr.Reason(st),
+		RequestID: requestID,
+	}
+	if code == statusClientClosed {
+		p.Title = "Client Closed Request"
+	}
+	if code >= http.StatusInternalServerError {
+		return p
+	}
+
+	p.Detail = st.Message()
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.BadRequest:
+			for _, v := range d.GetFieldViolations() {
+				p.Errors = append(p.Errors, FieldProblem{
+					Field:  v.GetField(),
+					Detail: v.GetDescription(),
+				})
+			}
+		case *errdetails.RetryInfo:
+			p.RetryAfter = d.GetRetryDelay().AsDuration()
+		}
+	}
+	return p
+}
+
+func toStatus(err error) *status.Status {
+	if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+		return apierr.New(codes.Unavailable, apierr.ReasonUnavailable, "service temporarily unavailable")
+	}
+	// the status in the chain, not FromError's, which takes err's text
+	var se interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &se) {
+		return se.GRPCStatus()
+	}
+	if st := status.FromContextError(err); st.Code() != codes.Unknown {
+		return st
+	}
+	return apierr.New(codes.Internal, apierr.ReasonInternal, err.Error())
+}
+
+// Problem writes err as application/problem+json.
+func (c *ctx) Problem(err error) error {
+	rq, _ := c.r.Context().Value(ck.ReqKey).(string)
+	p := NewProblem(err, rq)
+
+	if p.RetryAfter > 0 {
+		c.w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(p.RetryAfter.Seconds()))))
+	}
+	c.w.Header().Set("Content-Type", "application/problem+json")
+	c.w.WriteHeader(p.Status)
+	return json.NewEncoder(c.w).Encode(p)
+}
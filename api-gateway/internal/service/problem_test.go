@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sony/gobreaker/v2"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/Votline/3l1/protos/apierr"
+)
+
+func TestHTTPStatus(t *testing.T) {
+	tests := []struct {
+		code codes.Code
+		want int
+	}{
+		{codes.OK, http.StatusOK},
+		{codes.Canceled, statusClientClosed},
+		{codes.Unknown, http.StatusInternalServerError},
+		{codes.InvalidArgument, http.StatusBadRequest},
+		{codes.DeadlineExceeded, http.StatusGatewayTimeout},
+		{codes.NotFound, http.StatusNotFound},
+		{codes.AlreadyExists, http.StatusConflict},
+		{codes.PermissionDenied, http.StatusForbidden},
+		{codes.ResourceExhausted, http.StatusTooManyRequests},
+		{codes.FailedPrecondition, http.StatusBadRequest},
+		{codes.Aborted, http.StatusConflict},
+		{codes.OutOfRange, http.StatusBadRequest},
+		{codes.Unimplemented, http.StatusNotImplemented},
+		{codes.Internal, http.StatusInternalServerError},
+		{codes.Unavailable, http.StatusServiceUnavailable},
+		{codes.DataLoss, http.StatusInternalServerError},
+		{codes.Unauthenticated, http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		if got := HTTPStatus(tt.code); got != tt.want {
+			t.Errorf("HTTPStatus(%s) = %d, want %d", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestNewProblem(t *testing.T) {
+	limited := apierr.New(codes.ResourceExhausted, apierr.ReasonRateLimited, "slow down",
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(1500 * time.Millisecond)})
+	invalid := apierr.New(codes.InvalidArgument, apierr.ReasonValidationFailed, "invalid Email: bad",
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "Email", Description: "bad"},
+		}})
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+		wantDetail string
+		wantFields int
+		wantRetry  time.Duration
+	}{
+		{"domain error in chain", fmt.Errorf("rpc: %w", apierr.NotFound("user not found")),
+			http.StatusNotFound, apierr.ReasonNotFound, "user not found", 0, 0},
+		{"field violations", invalid.Err(),
+			http.StatusBadRequest, apierr.ReasonValidationFailed, "invalid Email: bad", 1, 0},
+		{"retry info", limited.Err(),
+			http.StatusTooManyRequests, apierr.ReasonRateLimited, "slow down", 0, 1500 * time.Millisecond},
+		{"bare status", status.Error(codes.PermissionDenied, "no"),
+			http.StatusForbidden, apierr.ReasonPermissionDenied, "no", 0, 0},
+		{"open breaker", gobreaker.ErrOpenState,
+			http.StatusServiceUnavailable, apierr.ReasonUnavailable, "", 0, 0},
+		{"half open breaker", gobreaker.ErrTooManyRequests,
+			http.StatusServiceUnavailable, apierr.ReasonUnavailable, "", 0, 0},
+		{"client went away", context.Canceled,
+			statusClientClosed, apierr.ReasonCanceled, "context canceled", 0, 0},
+		{"server error hides detail", status.Error(codes.Internal, "pq: secret table"),
+			http.StatusInternalServerError, apierr.ReasonInternal, "", 0, 0},
+		{"untyped error", errors.New("boom"),
+			http.StatusInternalServerError, apierr.ReasonInternal, "", 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewProblem(tt.err, "rq-1")
+			if p.Status != tt.wantStatus || p.Code != tt.wantCode || p.Detail != tt.wantDetail {
+				t.Errorf("problem = %d %s %q, want %d %s %q",
+					p.Status, p.Code, p.Detail, tt.wantStatus, tt.wantCode, tt.wantDetail)
+			}
+			if len(p.Errors) != tt.wantFields || p.RetryAfter != tt.wantRetry {
+				t.Errorf("errors = %v, retry after %s", p.Errors, p.RetryAfter)
+			}
+			if p.RequestID != "rq-1" || p.Type != "about:blank" || p.Title == "" {
+				t.Errorf("problem = %+v", p)
+			}
+		})
+	}
+}
+
+func TestProblemRetryAfterHeader(t *testing.T) {
+	st := apierr.New(codes.ResourceExhausted, apierr.ReasonRateLimited, "slow down",
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(1500 * time.Millisecond)})
+
+	w := httptest.NewRecorder()
+	NewContext(w, httptest.NewRequest(http.MethodGet, "/", nil)).Problem(st.Err())
+	if got := w.Header().Get("Retry-After"); got != "2" {
+		t.Errorf("Retry-After = %q, want rounded up to 2", got)
+	}
+}
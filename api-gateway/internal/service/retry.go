@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryPolicy says how often and on which codes a method is retried.
+// AttemptTimeout bounds each attempt on top of the request context.
+type RetryPolicy struct {
+	MaxAttempts    int
+	BaseDelay      time.Duration
+	MaxDelay       time.Duration
+	AttemptTimeout time.Duration
+	Codes          []codes.Code
+}
+
+var (
+	// Idempotent methods can run twice without harm, so transient
+	// failures are retried.
+	Idempotent = RetryPolicy{
+		MaxAttempts:    3,
+		BaseDelay:      100 * time.Millisecond,
+		MaxDelay:       2 * time.Second,
+		AttemptTimeout: 10 * time.Second,
+		Codes: []codes.Code{
+			codes.Unavailable,
+			codes.DeadlineExceeded,
+			codes.ResourceExhausted,
+			codes.Aborted,
+		},
+	}
+	// NoRetry is the default, a second AddOrder is a second order.
+	NoRetry = RetryPolicy{MaxAttempts: 1}
+)
+
+// Retry budget, as in gRPC retry throttling: failures cost a token,
+// successes earn back a fraction, and no retries run below half.
+const (
+	budgetTokens = 10
+	budgetRatio  = 0.1
+)
+
+type retryBudget struct {
+	mu     sync.Mutex
+	tokens float64
+}
+
+func (b *retryBudget) record(ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if ok {
+		b.tokens = min(budgetTokens, b.tokens+budgetRatio)
+	} else {
+		b.tokens = max(0, b.tokens-1)
+	}
+}
+
+func (b *retryBudget) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.tokens > budgetTokens/2
+}
+
+// Retrier retries the calls of one downstream service under its
+// per-method policies and a budget shared by all of them.
+type Retrier struct {
+	name     string
+	policies map[string]RetryPolicy
+	budget   *retryBudget
+	log      *zap.Logger
+}
+
+// NewRetrier takes policies by short method name, e.g. "GetUser".
+// Methods without an entry are not retried.
+func NewRetrier(name string, policies map[string]RetryPolicy, log *zap.Logger) *Retrier {
+	return &Retrier{
+		name:     name,
+		policies: policies,
+		budget:   &retryBudget{tokens: budgetTokens},
+		log:      log,
+	}
+}
+
+func (rt *Retrier) policy(fullMethod string) RetryPolicy {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	if p, ok := rt.policies[method]; ok {
+		return p
+	}
+	return NoRetry
+}
+
+// Interceptor is installed on the service's connection. The error of
+// the last attempt is returned as is, status code included.
+func (rt *Retrier) Interceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any,
+		cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+
+		p := rt.policy(method)
+
+		var err error
+		for attempt := 0; attempt < max(p.MaxAttempts, 1); attempt++ {
+			if attempt > 0 {
+				if !rt.budget.allow() {
+					rt.log.Warn("Retry budget exhausted",
+						zap.String("service", rt.name),
+						zap.String("method", method))
+					return err
+				}
+				if !sleep(ctx, backoff(p, attempt)) {
+					return err
+				}
+				rt.log.Warn("Retrying rpc",
+					zap.String("service", rt.name),
+					zap.String("method", method),
+					zap.Int("attempt", attempt+1),
+					zap.Error(err))
+			}
+
+			err = rt.invoke(ctx, p, method, req, reply, cc, invoker, opts...)
+			rt.budget.record(err == nil || !retryable(p, err))
+			if err == nil || !retryable(p, err) || ctx.Err() != nil {
+				return err
+			}
+		}
+		return err
+	}
+}
+
+func (rt *Retrier) invoke(ctx context.Context, p RetryPolicy, method string, req, reply any,
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+
+	if p.AttemptTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.AttemptTimeout)
+		defer cancel()
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+func retryable(p RetryPolicy, err error) bool {
+	st, ok := status.FromError(err)
+	return ok && slices.Contains(p.Codes, st.Code())
+}
+
+// backoff is exponential with full jitter, so that clients failing
+// together do not come back together.
+func backoff(p RetryPolicy, attempt int) time.Duration {
+	d := p.BaseDelay << (attempt - 1)
+	if d <= 0 || d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if d <= 0 {
+		return 0
+	}
+	return rand.N(d)
+}
+
+// sleep waits for d unless ctx ends first or its deadline would pass
+// during the wait.
+func sleep(ctx context.Context, d time.Duration) bool {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+		return false
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
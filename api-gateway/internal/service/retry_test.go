@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBackoff(t *testing.T) {
+	p := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	tests := []struct {
+		attempt int
+		ceiling time.Duration
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{3, 400 * time.Millisecond},
+		{4, 800 * time.Millisecond},
+		{5, time.Second},
+		// the shift overflows, the cap still holds
+		{80, time.Second},
+	}
+	for _, tt := range tests {
+		for range 200 {
+			if d := backoff(p, tt.attempt); d < 0 || d >= tt.ceiling {
+				t.Fatalf("backoff(%d) = %s, want in [0, %s)", tt.attempt, d, tt.ceiling)
+			}
+		}
+	}
+
+	if d := backoff(RetryPolicy{}, 1); d != 0 {
+		t.Errorf("backoff without delays = %s, want 0", d)
+	}
+}
+
+func TestRetryBudget(t *testing.T) {
+	b := &retryBudget{tokens: budgetTokens}
+	if !b.allow() {
+		t.Fatal("a full budget refuses retries")
+	}
+
+	for range budgetTokens / 2 {
+		b.record(false)
+	}
+	if b.allow() {
+		t.Errorf("budget at %.1f still allows retries", b.tokens)
+	}
+
+	b.record(true)
+	if !b.allow() {
+		t.Errorf("budget at %.1f does not recover after a success", b.tokens)
+	}
+
+	for range 3 * budgetTokens {
+		b.record(false)
+	}
+	if b.tokens != 0 {
+		t.Errorf("tokens = %.1f, want the floor 0", b.tokens)
+	}
+	for range 1000 {
+		b.record(true)
+	}
+	if b.tokens != budgetTokens {
+		t.Errorf("tokens = %.1f, want the cap %d", b.tokens, budgetTokens)
+	}
+}
+
+func TestRetrierInterceptor(t *testing.T) {
+	fast := Idempotent
+	fast.BaseDelay, fast.MaxDelay = time.Millisecond, time.Millisecond
+
+	unavailable := status.Error(codes.Unavailable, "down")
+	notFound := status.Error(codes.NotFound, "missing")
+
+	tests := []struct {
+		name      string
+		method    string
+		results   []error
+		budget    float64
+		wantCalls int
+		wantCode  codes.Code
+	}{
+		{"success", "/pkg.Svc/Get", []error{nil}, budgetTokens, 1, codes.OK},
+		{"recovers", "/pkg.Svc/Get", []error{unavailable, nil}, budgetTokens, 2, codes.OK},
+		{"gives up", "/pkg.Svc/Get", []error{unavailable, unavailable, unavailable, nil}, budgetTokens, 3, codes.Unavailable},
+		{"not retryable code", "/pkg.Svc/Get", []error{notFound, nil}, budgetTokens, 1, codes.NotFound},
+		{"write is not retried", "/pkg.Svc/Add", []error{unavailable, nil}, budgetTokens, 1, codes.Unavailable},
+		{"budget spent", "/pkg.Svc/Get", []error{unavailable, nil}, budgetTokens / 2, 1, codes.Unavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt := NewRetrier("test", map[string]RetryPolicy{"Get": fast}, zap.NewNop())
+			rt.budget.tokens = tt.budget
+
+			calls := 0
+			invoker := func(ctx context.Context, method string, req, reply any,
+				cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				err := tt.results[calls]
+				calls++
+				return err
+			}
+
+			err := rt.Interceptor()(context.Background(), tt.method, nil, nil, nil, invoker)
+			if calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, tt.wantCalls)
+			}
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("code = %s, want %s", code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestSleepRespectsDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if sleep(ctx, time.Second) {
+		t.Error("slept past the deadline")
+	}
+	if !sleep(context.Background(), time.Millisecond) {
+		t.Error("short sleep without deadline failed")
+	}
+}
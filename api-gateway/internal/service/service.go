@@ -2,21 +2,27 @@ package service
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
+	"reflect"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi"
 	"github.com/go-playground/validator/v10"
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/sony/gobreaker/v2"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-)
 
-const maxRetries = 3
+	"gateway/internal/cbreaker"
+
+	"github.com/Votline/3l1/protos/apierr"
+)
 
 type Service interface {
 	Close(context.Context) error
@@ -39,8 +45,49 @@ func (c *ctx) Context() context.Context {
 	return c.r.Context()
 }
 
+// ssoStateTTL matches the lifetime of the state in user-service.
+const ssoStateTTL = 10 * time.Minute
+
+// SetSSOState pins an SSO login to this browser, so a callback started
+// elsewhere cannot log it into someone else's account.
+func (c *ctx) SetSSOState(state string) {
+	http.SetCookie(c.w, &http.Cookie{
+		Name:     "sso_state",
+		Value:    state,
+		Path:     "/api/users/sso/",
+		MaxAge:   int(ssoStateTTL.Seconds()),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// CheckSSOState reports whether state is the one SetSSOState gave this
+// browser, and clears it either way.
+func (c *ctx) CheckSSOState(state string) bool {
+	ck, err := c.r.Cookie("sso_state")
+	http.SetCookie(c.w, &http.Cookie{
+		Name:     "sso_state",
+		Value:    "",
+		Path:     "/api/users/sso/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	if err != nil || ck.Value == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(ck.Value), []byte(state)) == 1
+}
+
+// Bind decodes the JSON body into v. A body that does not decode is the
+// caller's fault, so the error answers as a 400 through Problem.
 func (c *ctx) Bind(v any) error {
-	return json.NewDecoder(c.r.Body).Decode(v)
+	if err := json.NewDecoder(c.r.Body).Decode(v); err != nil {
+		return apierr.Invalid("Malformed request body: " + err.Error())
+	}
+	return nil
 }
 
 func (c *ctx) JSON(status int, v any) error {
@@ -61,75 +108,73 @@ func (c *ctx) SetSession(key string) {
 	})
 }
 
+func (c *ctx) ClearSession() {
+	http.SetCookie(c.w, &http.Cookie{
+		Name:     "session_key",
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   os.Getenv("mode") == "production",
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// Validate checks req and, when there is a response to write, answers
+// a failure with a 400 problem listing the fields that did not pass.
 func (c *ctx) Validate(req any) error {
 	validate := validator.New()
+	validate.RegisterTagNameFunc(jsonName)
 	if err := validate.Struct(req); err != nil {
 		if c.w != nil {
-			http.Error(c.w, err.Error(), http.StatusBadRequest)
+			c.Problem(validationStatus(err).Err())
 		}
 		return err
 	}
 	return nil
 }
 
-func rpc[T any](fn func() (T, error)) (T, error) {
-	var zero T
-	for i := 0; i < maxRetries; i++ {
-		res, err := fn()
-		if err == nil {
-			return res, nil
-		}
-
-		if !shouldRetry(err) {
-			return zero, err
-		}
-
-		time.Sleep(time.Duration(i+1) * time.Second)
+// jsonName names a field as clients send it.
+func jsonName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return f.Name
 	}
-
-	return zero, fmt.Errorf("max retries exceeded")
+	return name
 }
 
-func shouldRetry(err error) bool {
-	st, ok := status.FromError(err)
-	if ok {
-		switch st.Code() {
-		case
-			codes.Canceled,
-			codes.DeadlineExceeded,
-			codes.ResourceExhausted,
-			codes.Aborted,
-			codes.Unavailable,
-			codes.DataLoss:
-
-			return true
-		case
-			codes.InvalidArgument,
-			codes.NotFound,
-			codes.AlreadyExists,
-			codes.PermissionDenied,
-			codes.FailedPrecondition,
-			codes.OutOfRange,
-			codes.Unimplemented,
-			codes.Internal,
-			codes.Unauthenticated:
-
-			return false
-		default:
-			return false
-		}
+func validationStatus(err error) *status.Status {
+	var ves validator.ValidationErrors
+	if !errors.As(err, &ves) || len(ves) == 0 {
+		return apierr.New(codes.InvalidArgument, apierr.ReasonInvalidArgument, err.Error())
 	}
 
-	return false
+	br := &errdetails.BadRequest{}
+	for _, fe := range ves {
+		rule := fe.Tag()
+		if fe.Param() != "" {
+			rule += "=" + fe.Param()
+		}
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       fe.Field(),
+			Description: "must satisfy " + rule,
+		})
+	}
+	v := br.FieldViolations[0]
+	return apierr.New(codes.InvalidArgument, apierr.ReasonValidationFailed,
+		"invalid "+v.GetField()+": "+v.GetDescription(), br)
 }
 
-func Execute[T any](cb *gobreaker.CircuitBreaker[any], fn func() (T, error)) (T, error) {
+func Execute[T any](cb *cbreaker.Breaker, fn func() (T, error)) (T, error) {
 	var zero T
 
+	// retries happen below, in the connection's Retrier, so the breaker
+	// sees one outcome per call
 	resCb, err := cb.Execute(func() (any, error) {
-		return rpc(func() (T, error) {
-			return fn()
-		})
+		return fn()
 	})
 
 	if err != nil {
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestValidateWritesProblem(t *testing.T) {
+	req := struct {
+		Name  string `json:"name"  validate:"required"`
+		Email string `json:"email" validate:"required,email"`
+		ID    string `validate:"len=36"`
+	}{Email: "nope", ID: "1"}
+
+	w := httptest.NewRecorder()
+	c := NewContext(w, httptest.NewRequest(http.MethodPost, "/", nil))
+	if err := c.Validate(req); err == nil {
+		t.Fatal("Validate passed an invalid request")
+	}
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/problem+json" {
+		t.Errorf("content type = %q", ct)
+	}
+	var p Problem
+	if err := json.NewDecoder(w.Body).Decode(&p); err != nil {
+		t.Fatal(err)
+	}
+	if p.Code != "VALIDATION_FAILED" {
+		t.Errorf("code = %q", p.Code)
+	}
+	want := []FieldProblem{
+		{Field: "name", Detail: "must satisfy required"},
+		{Field: "email", Detail: "must satisfy email"},
+		{Field: "ID", Detail: "must satisfy len=36"},
+	}
+	if len(p.Errors) != len(want) {
+		t.Fatalf("errors = %+v, want %+v", p.Errors, want)
+	}
+	for i := range want {
+		if p.Errors[i] != want[i] {
+			t.Errorf("errors[%d] = %+v, want %+v", i, p.Errors[i], want[i])
+		}
+	}
+}
+
+func TestBindMalformedBody(t *testing.T) {
+	w := httptest.NewRecorder()
+	c := NewContext(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("{")))
+
+	var v struct{}
+	err := c.Bind(&v)
+	if err == nil {
+		t.Fatal("Bind accepted a malformed body")
+	}
+	c.Problem(err)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,252 @@
+package users
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+
+	ck "gateway/internal/contextKeys"
+	"gateway/internal/service"
+	"github.com/Votline/3l1/protos/apierr"
+	pb "github.com/Votline/3l1/protos/generated-user"
+)
+
+func (uc *UsersClient) AuthAPIKey(ctx context.Context, key, rq string) (ck.UserInfo, error) {
+	const op = "usersClient.AuthAPIKey"
+
+	c := service.NewContext(nil, nil)
+	req := struct {
+		key string `validate:"required,max=200"`
+	}{key: key}
+
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return ck.UserInfo{}, err
+	}
+
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	res, err := service.Execute(uc.cb, func() (*pb.AuthAPIKeyRes, error) {
+		return uc.client.AuthAPIKey(ctx, &pb.AuthAPIKeyReq{
+			Key: req.key,
+		})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return ck.UserInfo{}, err
+	}
+
+	uc.log.Info("Successfully authenticated API key",
+		zap.String("key id", res.KeyId),
+		zap.String("user id", res.UserId))
+
+	return ck.UserInfo{
+		Role:      res.Role,
+		UserID:    res.UserId,
+		Verified:  res.EmailVerified,
+		Perms:     res.Permissions,
+		KeyID:     res.KeyId,
+		RateLimit: int64(res.RateLimit),
+	}, nil
+}
+
+func (uc *UsersClient) createAPIKey(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.createAPIKey"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		Name      string   `json:"name"       validate:"required,max=50"`
+		Scopes    []string `json:"scopes"     validate:"required,min=1,unique,dive,required"`
+		RateLimit uint32   `json:"rate_limit" validate:"lte=10000"`
+		role      string
+		userID    string `validate:"required,uuid"`
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	if err := c.Bind(&req); err != nil {
+		uc.log.Error("Failed to bind request",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	// keys must not be able to mint more keys, nor impersonation outlive
+	// its session through one
+	if ui.Delegated() || ui.ActorID != "" {
+		c.Problem(apierr.PermissionDenied("creating API keys requires the user's own session"))
+		return
+	}
+	req.role = ui.Role
+	req.userID = ui.UserID
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.CreateAPIKeyRes, error) {
+		return uc.client.CreateAPIKey(c.Context(), &pb.CreateAPIKeyReq{
+			Role:      req.role,
+			UserId:    req.userID,
+			Name:      req.Name,
+			Scopes:    req.Scopes,
+			RateLimit: req.RateLimit,
+		})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	uc.log.Info("Created API key",
+		zap.String("user id", req.userID),
+		zap.String("key id", res.Key.GetId()))
+
+	out := apiKeyJSON(res.Key)
+	out["key"] = res.Secret
+	c.JSON(http.StatusCreated, out)
+}
+
+func (uc *UsersClient) listAPIKeys(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.listAPIKeys"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		userID string `validate:"required,uuid"`
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	if ui.Delegated() {
+		c.Problem(apierr.PermissionDenied("delegated credentials cannot manage API keys"))
+		return
+	}
+	req.userID = ui.UserID
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.ListAPIKeysRes, error) {
+		return uc.client.ListAPIKeys(c.Context(), &pb.ListAPIKeysReq{
+			UserId: req.userID,
+		})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	keys := make([]map[string]any, len(res.Keys))
+	for i, k := range res.Keys {
+		keys[i] = apiKeyJSON(k)
+	}
+	c.JSON(http.StatusOK, map[string]any{"keys": keys})
+}
+
+func (uc *UsersClient) revokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.revokeAPIKey"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		userID string `validate:"required,uuid"`
+		keyID  string `validate:"required,uuid"`
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	if ui.Delegated() {
+		c.Problem(apierr.PermissionDenied("delegated credentials cannot manage API keys"))
+		return
+	}
+	req.userID = ui.UserID
+	req.keyID = chi.URLParam(r, "keyId")
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	if _, err := service.Execute(uc.cb, func() (*pb.RevokeAPIKeyRes, error) {
+		return uc.client.RevokeAPIKey(c.Context(), &pb.RevokeAPIKeyReq{
+			UserId: req.userID,
+			KeyId:  req.keyID,
+		})
+	}); err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	uc.log.Info("Revoked API key",
+		zap.String("user id", req.userID),
+		zap.String("key id", req.keyID))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func apiKeyJSON(k *pb.APIKey) map[string]any {
+	out := map[string]any{
+		"id":           k.GetId(),
+		"name":         k.GetName(),
+		"scopes":       k.GetScopes(),
+		"rate_limit":   k.GetRateLimit(),
+		"created_at":   k.GetCreatedAt().AsTime(),
+		"last_used_at": nil,
+	}
+	if k.GetLastUsedAt() != nil {
+		out["last_used_at"] = k.GetLastUsedAt().AsTime()
+	}
+	return out
+}
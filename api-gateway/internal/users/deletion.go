@@ -0,0 +1,103 @@
+package users
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+
+	ck "gateway/internal/contextKeys"
+	"gateway/internal/service"
+	"github.com/Votline/3l1/protos/apierr"
+	pb "github.com/Votline/3l1/protos/generated-user"
+)
+
+func (uc *UsersClient) listDeletions(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.listDeletions"
+
+	c := service.NewContext(w, r)
+	qs := r.URL.Query()
+	req := struct {
+		ID    string `validate:"omitempty,uuid"`
+		All   string `validate:"omitempty,boolean"`
+		Limit string `validate:"omitempty,number"`
+		role  string
+	}{
+		ID:    chi.URLParam(r, "deletionId"),
+		All:   qs.Get("all"),
+		Limit: qs.Get("limit"),
+	}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	req.role = ui.Role
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	pbReq := &pb.ListDeletionsReq{
+		Role:        req.role,
+		Permissions: ui.Perms,
+		Id:          req.ID,
+	}
+	pbReq.All, _ = strconv.ParseBool(req.All)
+	if req.Limit != "" {
+		limit, _ := strconv.ParseUint(req.Limit, 10, 32)
+		pbReq.Limit = uint32(limit)
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.ListDeletionsRes, error) {
+		return uc.client.ListDeletions(c.Context(), pbReq)
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	out := make([]map[string]any, 0, len(res.Deletions))
+	for _, d := range res.Deletions {
+		out = append(out, map[string]any{
+			"id":                d.Id,
+			"user_id":           d.UserId,
+			"requested_by":      d.RequestedBy,
+			"state":             d.State,
+			"attempts":          d.Attempts,
+			"last_error":        d.LastError,
+			"orders_cancelled":  d.OrdersCancelled,
+			"orders_anonymised": d.OrdersAnonymised,
+			"next_attempt_at":   d.NextAttemptAt.AsTime().Format(time.RFC3339),
+			"created_at":        d.CreatedAt.AsTime().Format(time.RFC3339),
+			"updated_at":        d.UpdatedAt.AsTime().Format(time.RFC3339),
+		})
+	}
+
+	if req.ID != "" {
+		if len(out) == 0 {
+			c.Problem(apierr.NotFound("deletion not found"))
+			return
+		}
+		c.JSON(http.StatusOK, out[0])
+		return
+	}
+
+	c.JSON(http.StatusOK, map[string]any{
+		"deletions": out,
+	})
+}
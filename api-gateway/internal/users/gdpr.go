@@ -0,0 +1,195 @@
+package users
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+
+	ck "gateway/internal/contextKeys"
+	"gateway/internal/service"
+	"github.com/Votline/3l1/protos/apierr"
+	pb "github.com/Votline/3l1/protos/generated-user"
+)
+
+func (uc *UsersClient) exportUserData(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.exportUserData"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		role     string
+		userID   string `validate:"required,len=36"`
+		targetID string `validate:"required,len=36"`
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	if ui.Delegated() {
+		c.Problem(apierr.PermissionDenied("delegated credentials cannot export user data"))
+		return
+	}
+	req.role = ui.Role
+	req.userID = ui.UserID
+	req.targetID = chi.URLParam(r, "userId")
+	if req.targetID == "me" {
+		req.targetID = req.userID
+	}
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.ExportUserDataRes, error) {
+		return uc.client.ExportUserData(c.Context(), &pb.ExportUserDataReq{
+			Role:     req.role,
+			UserId:   req.userID,
+			TargetId: req.targetID,
+		})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	uc.log.Info("Successfully exported user data",
+		zap.String("target user id", req.targetID),
+		zap.Int("bytes", len(res.Archive)))
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+res.Filename+`"`)
+	w.Header().Set("Content-Length", strconv.Itoa(len(res.Archive)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(res.Archive)
+}
+
+func (uc *UsersClient) eraseUser(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.eraseUser"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		role     string
+		userID   string `validate:"required,len=36"`
+		targetID string `validate:"required,len=36"`
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	if ui.Delegated() {
+		c.Problem(apierr.PermissionDenied("delegated credentials cannot erase accounts"))
+		return
+	}
+	req.role = ui.Role
+	req.userID = ui.UserID
+	req.targetID = chi.URLParam(r, "userId")
+	if req.targetID == "me" {
+		req.targetID = req.userID
+	}
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.EraseUserRes, error) {
+		return uc.client.EraseUser(c.Context(), &pb.EraseUserReq{
+			Role:     req.role,
+			UserId:   req.userID,
+			TargetId: req.targetID,
+		})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	uc.log.Info("Successfully erased user",
+		zap.String("record id", res.Record.Id))
+
+	if req.targetID == req.userID {
+		c.ClearSession()
+	}
+
+	c.JSON(http.StatusOK, erasureJSON(res.Record))
+}
+
+func (uc *UsersClient) getErasure(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.getErasure"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		id string `validate:"required,uuid"`
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	req.id = chi.URLParam(r, "recordId")
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.GetErasureRes, error) {
+		return uc.client.GetErasure(c.Context(), &pb.GetErasureReq{
+			Id: req.id,
+		})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, erasureJSON(res.Record))
+}
+
+func erasureJSON(rec *pb.ErasureRecord) map[string]any {
+	return map[string]any{
+		"id":           rec.Id,
+		"subject_hash": rec.SubjectHash,
+		"requested_by": rec.RequestedBy,
+		"steps":        rec.Steps,
+		"completed_at": rec.CompletedAt.AsTime().Format(time.RFC3339),
+		"receipt":      rec.Receipt,
+	}
+}
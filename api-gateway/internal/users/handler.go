@@ -2,13 +2,16 @@ package users
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/go-chi/chi"
 	"go.uber.org/zap"
 
+	"gateway/internal/clientip"
 	ck "gateway/internal/contextKeys"
 	"gateway/internal/service"
+	"github.com/Votline/3l1/protos/apierr"
 	pb "github.com/Votline/3l1/protos/generated-user"
 )
 
@@ -18,9 +21,9 @@ func (uc *UsersClient) regUser(w http.ResponseWriter, r *http.Request) {
 	c := service.NewContext(w, r)
 	req := struct {
 		Name  string `json:"name"     validate:"required,min=2,max=50"`
-		Role  string `json:"role"     validate:"oneof=admin user guest dev"`
 		Email string `json:"email"    validate:"email"`
 		Pswd  string `json:"password" validate:"required,min=8"`
+		Code  string `json:"invite_code" validate:"max=64"`
 	}{}
 
 	rq, _ := r.Context().Value(ck.ReqKey).(string)
@@ -36,7 +39,7 @@ func (uc *UsersClient) regUser(w http.ResponseWriter, r *http.Request) {
 			zap.String("op", op),
 			zap.String("request id", rq),
 			zap.Error(err))
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		c.Problem(err)
 		return
 	}
 
@@ -48,16 +51,15 @@ func (uc *UsersClient) regUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	uc.log.Info("Extracted data for reg user",
-		zap.String("role", req.Role))
+	uc.log.Info("Extracted data for reg user")
 
 	res, err := service.Execute(uc.cb, func() (*pb.RegRes, error) {
 		return uc.client.RegUser(c.Context(), &pb.RegReq{
-			Name:      req.Name,
-			Email:     req.Email,
-			Role:      req.Role,
-			Password:  req.Pswd,
-			RequestId: rq,
+			Name:       req.Name,
+			Email:      req.Email,
+			Password:   req.Pswd,
+			InviteCode: req.Code,
+			RequestId:  rq,
 		})
 	})
 
@@ -66,12 +68,11 @@ func (uc *UsersClient) regUser(w http.ResponseWriter, r *http.Request) {
 			zap.String("op", op),
 			zap.String("request id", rq),
 			zap.Error(err))
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		c.Problem(err)
 		return
 	}
 
-	uc.log.Info("Successfully added user",
-		zap.String("user role", req.Role))
+	uc.log.Info("Successfully added user")
 
 	c.SetSession(res.SessionKey)
 
@@ -85,8 +86,8 @@ func (uc *UsersClient) logUser(w http.ResponseWriter, r *http.Request) {
 
 	c := service.NewContext(w, r)
 	req := struct {
-		Name  string `json:"name"     validate:"required,min=2,max=50"`
-		Email string `json:"email"    validate:"email"`
+		Name  string `json:"name"     validate:"required_without=Email,omitempty,min=2,max=50"`
+		Email string `json:"email"    validate:"required_without=Name,omitempty,email"`
 		Pswd  string `json:"password" validate:"required,min=8"`
 	}{}
 
@@ -103,7 +104,7 @@ func (uc *UsersClient) logUser(w http.ResponseWriter, r *http.Request) {
 			zap.String("op", op),
 			zap.String("request id", rq),
 			zap.Error(err))
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		c.Problem(err)
 		return
 	}
 	if err := c.Validate(req); err != nil {
@@ -121,6 +122,7 @@ func (uc *UsersClient) logUser(w http.ResponseWriter, r *http.Request) {
 			Name:      req.Name,
 			Email:     req.Email,
 			Password:  req.Pswd,
+			ClientIp:  clientIP(r),
 			RequestId: rq,
 		})
 	})
@@ -129,7 +131,17 @@ func (uc *UsersClient) logUser(w http.ResponseWriter, r *http.Request) {
 			zap.String("op", op),
 			zap.String("request id", rq),
 			zap.Error(err))
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		c.Problem(err)
+		return
+	}
+
+	if res.MfaRequired {
+		uc.log.Info("Second factor required")
+		c.JSON(http.StatusOK, map[string]any{
+			"mfa_required":       true,
+			"mfa_token":          res.MfaToken,
+			"mfa_enrol_required": res.MfaEnrolRequired,
+		})
 		return
 	}
 
@@ -146,10 +158,10 @@ func (uc *UsersClient) delUser(w http.ResponseWriter, r *http.Request) {
 
 	c := service.NewContext(w, r)
 	req := struct {
-		sk        string `validate:"required,len=36"`
-		role      string `validate:"oneof=admin user guest dev"`
-		userId    string `validate:"required,len=36"`
-		delUserId string `validate:"required,len=36"`
+		SK        string `json:"-" validate:"required,len=36"`
+		Role      string `json:"-"`
+		UserID    string `json:"-" validate:"required,len=36"`
+		DelUserID string `json:"-" validate:"required,len=36"`
 	}{}
 
 	rqVal := r.Context().Value(ck.ReqKey)
@@ -159,7 +171,7 @@ func (uc *UsersClient) delUser(w http.ResponseWriter, r *http.Request) {
 	}
 	if rq == "" {
 		uc.log.Error("Request ID missing from context and header", zap.String("op", op))
-		http.Error(w, "internal error", http.StatusInternalServerError)
+		c.Problem(errors.New("internal error"))
 		return
 	}
 
@@ -167,14 +179,19 @@ func (uc *UsersClient) delUser(w http.ResponseWriter, r *http.Request) {
 	userInfo, _ := userVal.(ck.UserInfo)
 	if userInfo.UserID == "" || userInfo.Role == "" {
 		uc.log.Error("User data missing from context (JWT not applied?)", zap.String("op", op), zap.String("request id", rq))
-		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		c.Problem(apierr.Unauthenticated("unauthorized"))
 		return
 	}
-	req.role = userInfo.Role
-	req.userId = userInfo.UserID
-	req.delUserId = chi.URLParam(r, "delUserId")
-	if req.delUserId == "me" {
-		req.delUserId = req.userId
+	// the role behind a key or client is wider than what it was granted
+	if userInfo.Delegated() {
+		c.Problem(apierr.PermissionDenied("delegated credentials cannot delete accounts"))
+		return
+	}
+	req.Role = userInfo.Role
+	req.UserID = userInfo.UserID
+	req.DelUserID = chi.URLParam(r, "delUserId")
+	if req.DelUserID == "me" {
+		req.DelUserID = req.UserID
 	}
 	sk, err := r.Cookie("session_key")
 	if err != nil {
@@ -182,9 +199,10 @@ func (uc *UsersClient) delUser(w http.ResponseWriter, r *http.Request) {
 			zap.String("op", op),
 			zap.String("request id", rq),
 			zap.Error(err))
+		c.Problem(apierr.Unauthenticated("session required"))
 		return
 	}
-	req.sk = sk.Value
+	req.SK = sk.Value
 
 	if err := c.Validate(req); err != nil {
 		uc.log.Error("Failed to validate request data",
@@ -198,30 +216,35 @@ func (uc *UsersClient) delUser(w http.ResponseWriter, r *http.Request) {
 		zap.String("op", op),
 		zap.String("request id", rq))
 
-	if _, err := service.Execute(uc.cb, func() (*pb.DelUserRes, error) {
-		return uc.client.DelUser(context.Background(), &pb.DelUserReq{
-			Role:       req.role,
-			UserId:     req.userId,
-			DelUserId:  req.delUserId,
-			SessionKey: req.sk,
-			RequestId:  rq,
+	res, err := service.Execute(uc.cb, func() (*pb.DelUserRes, error) {
+		return uc.client.DelUser(c.Context(), &pb.DelUserReq{
+			Role:        req.Role,
+			UserId:      req.UserID,
+			DelUserId:   req.DelUserID,
+			SessionKey:  req.SK,
+			Permissions: userInfo.Perms,
+			RequestId:   rq,
 		})
-	}); err != nil {
+	})
+	if err != nil {
 		uc.log.Error("Rpc request failed",
 			zap.String("op", op),
 			zap.String("request id", rq),
 			zap.Error(err))
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		c.Problem(err)
 		return
 	}
 
-	uc.log.Info("Successfully deleted user",
-		zap.String("deleted user id", req.delUserId))
+	uc.log.Info("Started user deletion",
+		zap.String("deleted user id", req.DelUserID),
+		zap.String("deletion id", res.DeletionId))
 
-	w.WriteHeader(http.StatusOK)
+	c.JSON(http.StatusAccepted, map[string]string{
+		"deletion_id": res.DeletionId,
+	})
 }
 
-func (uc *UsersClient) ExtJWTData(tokenString, sk, rq string) (ck.UserInfo, error) {
+func (uc *UsersClient) ExtJWTData(ctx context.Context, tokenString, sk, rq string) (ck.UserInfo, error) {
 	const op = "usersClient.ExtJWTData"
 
 	c := service.NewContext(nil, nil)
@@ -245,7 +268,7 @@ func (uc *UsersClient) ExtJWTData(tokenString, sk, rq string) (ck.UserInfo, erro
 		zap.String("request id", rq))
 
 	res, err := service.Execute(uc.cb, func() (*pb.ExtJWTDataRes, error) {
-		return uc.client.ExtJWTData(context.Background(), &pb.ExtJWTDataReq{
+		return uc.client.ExtJWTData(ctx, &pb.ExtJWTDataReq{
 			Token:      req.token,
 			SessionKey: req.sk,
 			RequestId:  rq,
@@ -265,14 +288,18 @@ func (uc *UsersClient) ExtJWTData(tokenString, sk, rq string) (ck.UserInfo, erro
 		zap.String("role", res.Role))
 
 	return ck.UserInfo{
-		Role:   res.Role,
-		UserID: res.UserId,
+		Role:     res.Role,
+		UserID:   res.UserId,
+		Verified: res.EmailVerified,
+		Perms:    res.Permissions,
+		ActorID:  res.ActorId,
 	}, nil
 }
 
 func (uc *UsersClient) extUserId(w http.ResponseWriter, r *http.Request) {
 	const op = "usersClient.extUserId"
 
+	c := service.NewContext(w, r)
 	rq, _ := r.Context().Value(ck.ReqKey).(string)
 	if rq == "" {
 		rq = "no-request-id"
@@ -288,22 +315,227 @@ func (uc *UsersClient) extUserId(w http.ResponseWriter, r *http.Request) {
 			zap.String("op", op),
 			zap.String("request id", rq),
 			zap.Error(err))
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		c.Problem(apierr.Unauthenticated("session cookie required"))
 		return
 	}
 
-	data, err := uc.ExtJWTData(tokenString, sk.Value, rq)
+	data, err := uc.ExtJWTData(r.Context(), tokenString, sk.Value, rq)
 	if err != nil {
 		uc.log.Error("Failed to extract jwt data",
 			zap.String("op", op),
 			zap.String("request id", rq),
 			zap.Error(err))
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		c.Problem(err)
 		return
 	}
 
-	c := service.NewContext(w, r)
 	c.JSON(http.StatusOK, map[string]string{
 		"user_id": data.UserID,
 	})
 }
+
+func (uc *UsersClient) verifyEmail(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.verifyEmail"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		token string `validate:"required,uuid"`
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	req.token = r.URL.Query().Get("token")
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	if _, err := service.Execute(uc.cb, func() (*pb.VerifyEmailRes, error) {
+		return uc.client.VerifyEmail(c.Context(), &pb.VerifyEmailReq{
+			Token: req.token,
+		})
+	}); err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	uc.log.Info("Successfully verified email")
+
+	c.JSON(http.StatusOK, map[string]string{
+		"status": "verified",
+	})
+}
+
+func (uc *UsersClient) resendVerification(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.resendVerification"
+
+	c := service.NewContext(w, r)
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	ui, ok := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	if !ok || ui.UserID == "" {
+		c.Problem(apierr.Unauthenticated("user not authorized"))
+		return
+	}
+
+	if _, err := service.Execute(uc.cb, func() (*pb.ResendVerificationRes, error) {
+		return uc.client.ResendVerification(c.Context(), &pb.ResendVerificationReq{
+			UserId: ui.UserID,
+		})
+	}); err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	uc.log.Info("Verification email sent again",
+		zap.String("user id", ui.UserID))
+
+	c.JSON(http.StatusAccepted, map[string]string{
+		"status": "sent",
+	})
+}
+
+func (uc *UsersClient) unlockUser(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.unlockUser"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		role   string
+		UserID string `json:"-" validate:"required,uuid"`
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	req.role = ui.Role
+	req.UserID = chi.URLParam(r, "userId")
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	if _, err := service.Execute(uc.cb, func() (*pb.UnlockUserRes, error) {
+		return uc.client.UnlockUser(c.Context(), &pb.UnlockUserReq{
+			Role:        req.role,
+			Permissions: ui.Perms,
+			UserId:      req.UserID,
+		})
+	}); err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	uc.log.Info("Successfully unlocked user",
+		zap.String("unlocked user id", req.UserID))
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (uc *UsersClient) setUserRole(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.setUserRole"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		NewRole  string `json:"role" validate:"required,max=50"`
+		role     string
+		userID   string
+		TargetID string `json:"-" validate:"required,uuid"`
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	if err := c.Bind(&req); err != nil {
+		uc.log.Error("Failed to bind request",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	req.role = ui.Role
+	req.userID = ui.UserID
+	req.TargetID = chi.URLParam(r, "userId")
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	if _, err := service.Execute(uc.cb, func() (*pb.SetUserRoleRes, error) {
+		return uc.client.SetUserRole(c.Context(), &pb.SetUserRoleReq{
+			Role:        req.role,
+			Permissions: ui.Perms,
+			UserId:      req.userID,
+			TargetId:    req.TargetID,
+			NewRole:     req.NewRole,
+		})
+	}); err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	uc.log.Info("Successfully changed user role",
+		zap.String("target user id", req.TargetID),
+		zap.String("new role", req.NewRole))
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// clientIP is what login attempts are counted by, the same bucket the
+// rate limiter uses.
+func clientIP(r *http.Request) string {
+	addr := clientip.FromContext(r.Context())
+	if !addr.IsValid() {
+		return ""
+	}
+	return clientip.Bucket(addr)
+}
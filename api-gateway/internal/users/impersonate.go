@@ -0,0 +1,89 @@
+package users
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+
+	ck "gateway/internal/contextKeys"
+	"gateway/internal/service"
+	"github.com/Votline/3l1/protos/apierr"
+	pb "github.com/Votline/3l1/protos/generated-user"
+)
+
+func (uc *UsersClient) impersonate(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.impersonate"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		Reason   string `json:"reason" validate:"required,min=3,max=500"`
+		role     string
+		userID   string `validate:"required,len=36"`
+		targetID string `validate:"required,len=36"`
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	// only an admin's own session may start impersonation
+	if ui.Delegated() || ui.ActorID != "" {
+		c.Problem(apierr.PermissionDenied("impersonation requires an interactive session"))
+		return
+	}
+
+	if err := c.Bind(&req); err != nil {
+		uc.log.Error("Failed to bind request",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+	req.role = ui.Role
+	req.userID = ui.UserID
+	req.targetID = chi.URLParam(r, "userId")
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.ImpersonateRes, error) {
+		return uc.client.Impersonate(c.Context(), &pb.ImpersonateReq{
+			Role:        req.role,
+			Permissions: ui.Perms,
+			UserId:      req.userID,
+			TargetId:    req.targetID,
+			Reason:      req.Reason,
+		})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	uc.log.Warn("Started impersonation",
+		zap.String("request id", rq),
+		zap.String("actor id", req.userID),
+		zap.String("target user id", req.targetID))
+
+	c.SetSession(res.SessionKey)
+
+	c.JSON(http.StatusOK, map[string]any{
+		"token":      res.Token,
+		"expires_in": res.ExpiresIn,
+	})
+}
@@ -0,0 +1,264 @@
+package users
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+
+	ck "gateway/internal/contextKeys"
+	"gateway/internal/service"
+	pb "github.com/Votline/3l1/protos/generated-user"
+)
+
+func (uc *UsersClient) createInvite(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.createInvite"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		Role     string `json:"role"      validate:"required,max=50"`
+		Note     string `json:"note"      validate:"max=200"`
+		MaxUses  int32  `json:"max_uses"  validate:"min=1,max=10000"`
+		TTLHours uint32 `json:"ttl_hours" validate:"min=1,max=8760"`
+		role     string
+		userID   string `validate:"required,len=36"`
+	}{MaxUses: 1, TTLHours: 168}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	if err := c.Bind(&req); err != nil {
+		uc.log.Error("Failed to bind request",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	req.role = ui.Role
+	req.userID = ui.UserID
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.CreateInviteRes, error) {
+		return uc.client.CreateInvite(c.Context(), &pb.CreateInviteReq{
+			Role:        req.role,
+			Permissions: ui.Perms,
+			UserId:      req.userID,
+			InviteRole:  req.Role,
+			Note:        req.Note,
+			MaxUses:     req.MaxUses,
+			TtlHours:    req.TTLHours,
+		})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	uc.log.Info("Successfully created invite",
+		zap.String("invite id", res.Invite.Id))
+
+	// the code is shown once, only its hash is stored
+	out := inviteJSON(res.Invite)
+	out["code"] = res.Code
+	c.JSON(http.StatusCreated, out)
+}
+
+func (uc *UsersClient) listInvites(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.listInvites"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		All  string `validate:"omitempty,boolean"`
+		role string
+	}{All: r.URL.Query().Get("all")}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	req.role = ui.Role
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+	all, _ := strconv.ParseBool(req.All)
+
+	res, err := service.Execute(uc.cb, func() (*pb.ListInvitesRes, error) {
+		return uc.client.ListInvites(c.Context(), &pb.ListInvitesReq{
+			Role:        req.role,
+			Permissions: ui.Perms,
+			All:         all,
+		})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	out := make([]map[string]any, 0, len(res.Invites))
+	for _, inv := range res.Invites {
+		out = append(out, inviteJSON(inv))
+	}
+
+	c.JSON(http.StatusOK, map[string]any{
+		"invites": out,
+	})
+}
+
+func (uc *UsersClient) listRedemptions(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.listRedemptions"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		role     string
+		inviteID string `validate:"required,uuid"`
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	req.role = ui.Role
+	req.inviteID = chi.URLParam(r, "inviteId")
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.ListRedemptionsRes, error) {
+		return uc.client.ListRedemptions(c.Context(), &pb.ListRedemptionsReq{
+			Role:        req.role,
+			Permissions: ui.Perms,
+			InviteId:    req.inviteID,
+		})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	out := make([]map[string]any, 0, len(res.Redemptions))
+	for _, rd := range res.Redemptions {
+		out = append(out, map[string]any{
+			"user_id":     rd.UserId,
+			"name":        rd.Name,
+			"redeemed_at": rd.RedeemedAt.AsTime().Format(time.RFC3339),
+		})
+	}
+
+	c.JSON(http.StatusOK, map[string]any{
+		"redemptions": out,
+	})
+}
+
+func (uc *UsersClient) revokeInvite(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.revokeInvite"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		role     string
+		userID   string `validate:"required,len=36"`
+		inviteID string `validate:"required,uuid"`
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	req.role = ui.Role
+	req.userID = ui.UserID
+	req.inviteID = chi.URLParam(r, "inviteId")
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	if _, err := service.Execute(uc.cb, func() (*pb.RevokeInviteRes, error) {
+		return uc.client.RevokeInvite(c.Context(), &pb.RevokeInviteReq{
+			Role:        req.role,
+			Permissions: ui.Perms,
+			UserId:      req.userID,
+			InviteId:    req.inviteID,
+		})
+	}); err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	uc.log.Info("Successfully revoked invite",
+		zap.String("invite id", req.inviteID))
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func inviteJSON(inv *pb.Invite) map[string]any {
+	out := map[string]any{
+		"id":         inv.Id,
+		"role":       inv.Role,
+		"note":       inv.Note,
+		"max_uses":   inv.MaxUses,
+		"uses":       inv.Uses,
+		"expires_at": inv.ExpiresAt.AsTime().Format(time.RFC3339),
+		"created_by": inv.CreatedBy,
+		"created_at": inv.CreatedAt.AsTime().Format(time.RFC3339),
+	}
+	if inv.RevokedAt != nil {
+		out["revoked_at"] = inv.RevokedAt.AsTime().Format(time.RFC3339)
+	}
+	return out
+}
@@ -0,0 +1,261 @@
+package users
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+
+	ck "gateway/internal/contextKeys"
+	"gateway/internal/service"
+	"github.com/Votline/3l1/protos/apierr"
+	pb "github.com/Votline/3l1/protos/generated-user"
+)
+
+func (uc *UsersClient) verifyMFA(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.verifyMFA"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		MfaToken string `json:"mfa_token" validate:"required,uuid"`
+		Code     string `json:"code"      validate:"required,min=6,max=16"`
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	if err := c.Bind(&req); err != nil {
+		uc.log.Error("Failed to bind request",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.VerifyMFARes, error) {
+		return uc.client.VerifyMFA(c.Context(), &pb.VerifyMFAReq{
+			MfaToken: req.MfaToken,
+			Code:     req.Code,
+		})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	uc.log.Info("Successfully login with second factor")
+
+	c.SetSession(res.SessionKey)
+	c.JSON(http.StatusOK, map[string]string{
+		"token": res.Token,
+	})
+}
+
+// enrollTOTP serves both the authenticated route and the login-time
+// route, where the user is identified by the MFA challenge token.
+func (uc *UsersClient) enrollTOTP(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.enrollTOTP"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		MfaToken string `json:"mfa_token" validate:"omitempty,uuid"`
+		userID   string
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	if err := c.Bind(&req); err != nil {
+		uc.log.Error("Failed to bind request",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	req.userID = ui.UserID
+	if req.userID == "" && req.MfaToken == "" {
+		c.Problem(apierr.Invalid("mfa_token required"))
+		return
+	}
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.EnrollTOTPRes, error) {
+		return uc.client.EnrollTOTP(c.Context(), &pb.EnrollTOTPReq{
+			UserId:   req.userID,
+			MfaToken: req.MfaToken,
+		})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	uc.log.Info("Started TOTP enrolment")
+
+	c.JSON(http.StatusOK, map[string]string{
+		"secret":      res.Secret,
+		"otpauth_uri": res.OtpauthUri,
+	})
+}
+
+func (uc *UsersClient) confirmTOTP(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.confirmTOTP"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		MfaToken string `json:"mfa_token" validate:"omitempty,uuid"`
+		Code     string `json:"code"      validate:"required,len=6,numeric"`
+		userID   string
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	if err := c.Bind(&req); err != nil {
+		uc.log.Error("Failed to bind request",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	req.userID = ui.UserID
+	if req.userID == "" && req.MfaToken == "" {
+		c.Problem(apierr.Invalid("mfa_token required"))
+		return
+	}
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.ConfirmTOTPRes, error) {
+		return uc.client.ConfirmTOTP(c.Context(), &pb.ConfirmTOTPReq{
+			UserId:   req.userID,
+			MfaToken: req.MfaToken,
+			Code:     req.Code,
+		})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	uc.log.Info("Successfully enabled TOTP")
+
+	out := map[string]any{
+		"recovery_codes": res.RecoveryCodes,
+	}
+	if res.SessionKey != "" {
+		c.SetSession(res.SessionKey)
+		out["token"] = res.Token
+	}
+	c.JSON(http.StatusOK, out)
+}
+
+func (uc *UsersClient) setMFARole(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.setMFARole"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		Required   bool `json:"required"`
+		role       string
+		TargetRole string `json:"-" validate:"required,max=50"`
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	if err := c.Bind(&req); err != nil {
+		uc.log.Error("Failed to bind request",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	req.role = ui.Role
+	req.TargetRole = chi.URLParam(r, "role")
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	if _, err := service.Execute(uc.cb, func() (*pb.SetMFARoleRes, error) {
+		return uc.client.SetMFARole(c.Context(), &pb.SetMFARoleReq{
+			Role:        req.role,
+			Permissions: ui.Perms,
+			TargetRole:  req.TargetRole,
+			Required:    req.Required,
+		})
+	}); err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	uc.log.Info("Updated MFA policy",
+		zap.String("role", req.TargetRole),
+		zap.Bool("required", req.Required))
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,527 @@
+package users
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+
+	ck "gateway/internal/contextKeys"
+	"gateway/internal/service"
+	"github.com/Votline/3l1/protos/apierr"
+	pb "github.com/Votline/3l1/protos/generated-user"
+)
+
+func issuer() string {
+	if iss := os.Getenv("OAUTH_ISSUER"); iss != "" {
+		return iss
+	}
+	return "http://localhost:8080/api/users/oauth"
+}
+
+func (uc *UsersClient) AuthAccessToken(ctx context.Context, token, rq string) (ck.UserInfo, error) {
+	const op = "usersClient.AuthAccessToken"
+
+	c := service.NewContext(nil, nil)
+	req := struct {
+		token string `validate:"required,max=4096"`
+	}{token: token}
+
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return ck.UserInfo{}, err
+	}
+
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	res, err := service.Execute(uc.cb, func() (*pb.AuthAccessTokenRes, error) {
+		return uc.client.AuthAccessToken(ctx, &pb.AuthAccessTokenReq{
+			Token: req.token,
+		})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return ck.UserInfo{}, err
+	}
+
+	uc.log.Info("Successfully authenticated access token",
+		zap.String("client id", res.ClientId),
+		zap.String("user id", res.UserId))
+
+	return ck.UserInfo{
+		Role:     res.Role,
+		UserID:   res.UserId,
+		Verified: res.EmailVerified,
+		Perms:    res.Permissions,
+		ClientID: res.ClientId,
+	}, nil
+}
+
+func (uc *UsersClient) discovery(w http.ResponseWriter, r *http.Request) {
+	c := service.NewContext(w, r)
+	iss := issuer()
+
+	c.JSON(http.StatusOK, map[string]any{
+		"issuer":                                iss,
+		"authorization_endpoint":                iss + "/authorize",
+		"token_endpoint":                        iss + "/token",
+		"introspection_endpoint":                iss + "/introspect",
+		"revocation_endpoint":                   iss + "/revoke",
+		"userinfo_endpoint":                     iss + "/userinfo",
+		"jwks_uri":                              iss + "/jwks",
+		"registration_endpoint":                 iss + "/clients",
+		"scopes_supported":                      []string{"openid", "profile", "email", "orders:read", "orders:write"},
+		"response_types_supported":              []string{"code"},
+		"grant_types_supported":                 []string{"authorization_code", "client_credentials"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post", "none"},
+		"code_challenge_methods_supported":      []string{"S256"},
+		"claims_supported":                      []string{"sub", "name", "preferred_username", "email", "email_verified"},
+	})
+}
+
+func (uc *UsersClient) jwks(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.jwks"
+
+	c := service.NewContext(w, r)
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+
+	res, err := service.Execute(uc.cb, func() (*pb.JWKSRes, error) {
+		return uc.client.JWKS(c.Context(), &pb.JWKSReq{})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/jwk-set+json")
+	w.Write([]byte(res.Jwks))
+}
+
+func (uc *UsersClient) authorize(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.authorize"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		ResponseType        string `json:"response_type"         validate:"eq=code"`
+		ClientID            string `json:"client_id"             validate:"required,uuid"`
+		RedirectURI         string `json:"redirect_uri"          validate:"required,url"`
+		Scope               string `json:"scope"                 validate:"required,max=500"`
+		State               string `json:"state"                 validate:"max=500"`
+		CodeChallenge       string `json:"code_challenge"        validate:"required,min=43,max=128"`
+		CodeChallengeMethod string `json:"code_challenge_method" validate:"eq=S256"`
+		Nonce               string `json:"nonce"                 validate:"max=500"`
+		Approve             bool   `json:"approve"`
+		role                string
+		userID              string `validate:"required,uuid"`
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	// GET carries the request in the query, POST is the consent answer
+	if r.Method == http.MethodPost {
+		if err := c.Bind(&req); err != nil {
+			uc.log.Error("Failed to bind request",
+				zap.String("op", op),
+				zap.String("request id", rq),
+				zap.Error(err))
+			c.Problem(err)
+			return
+		}
+	} else {
+		q := r.URL.Query()
+		req.ResponseType = q.Get("response_type")
+		req.ClientID = q.Get("client_id")
+		req.RedirectURI = q.Get("redirect_uri")
+		req.Scope = q.Get("scope")
+		req.State = q.Get("state")
+		req.CodeChallenge = q.Get("code_challenge")
+		req.CodeChallengeMethod = q.Get("code_challenge_method")
+		req.Nonce = q.Get("nonce")
+	}
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	if ui.Delegated() || ui.ActorID != "" {
+		c.Problem(apierr.PermissionDenied("authorizing clients requires the user's own session"))
+		return
+	}
+	req.role = ui.Role
+	req.userID = ui.UserID
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.AuthorizeRes, error) {
+		return uc.client.Authorize(c.Context(), &pb.AuthorizeReq{
+			UserId:              req.userID,
+			Role:                req.role,
+			ResponseType:        req.ResponseType,
+			ClientId:            req.ClientID,
+			RedirectUri:         req.RedirectURI,
+			Scope:               req.Scope,
+			State:               req.State,
+			CodeChallenge:       req.CodeChallenge,
+			CodeChallengeMethod: req.CodeChallengeMethod,
+			Nonce:               req.Nonce,
+			Approve:             req.Approve,
+			ActorId:             ui.ActorID,
+		})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	if res.ConsentRequired {
+		c.JSON(http.StatusOK, map[string]any{
+			"consent_required": true,
+			"client_name":      res.ClientName,
+			"scopes":           res.Scopes,
+		})
+		return
+	}
+
+	uc.log.Info("Issued authorization code",
+		zap.String("user id", req.userID),
+		zap.String("client id", req.ClientID))
+
+	c.JSON(http.StatusOK, map[string]any{
+		"redirect_to": res.RedirectTo,
+	})
+}
+
+// oauthErrors are the RFC 6749 error codes user-service reports.
+var oauthErrors = []string{"invalid_client", "invalid_grant", "invalid_scope", "unauthorized_client", "unsupported_token_type"}
+
+// oauthError picks the error code out of a failed rpc, anything
+// unrecognised is reported as invalid_request.
+func oauthError(err error) (int, map[string]string) {
+	code := "invalid_request"
+	for _, e := range oauthErrors {
+		if strings.Contains(err.Error(), e) {
+			code = e
+			break
+		}
+	}
+	status := http.StatusBadRequest
+	if code == "invalid_client" {
+		status = http.StatusUnauthorized
+	}
+	return status, map[string]string{"error": code}
+}
+
+func (uc *UsersClient) token(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.token"
+
+	c := service.NewContext(w, r)
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	w.Header().Set("Cache-Control", "no-store")
+
+	if err := r.ParseForm(); err != nil {
+		c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	clientID, secret, ok := r.BasicAuth()
+	if !ok {
+		clientID = r.PostForm.Get("client_id")
+		secret = r.PostForm.Get("client_secret")
+	}
+
+	req := &pb.TokenReq{
+		GrantType:    r.PostForm.Get("grant_type"),
+		ClientId:     clientID,
+		ClientSecret: secret,
+		Code:         r.PostForm.Get("code"),
+		RedirectUri:  r.PostForm.Get("redirect_uri"),
+		CodeVerifier: r.PostForm.Get("code_verifier"),
+		Scope:        r.PostForm.Get("scope"),
+	}
+	if err := req.Validate(); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, map[string]string{
+			"error":             "invalid_request",
+			"error_description": err.Error(),
+		})
+		return
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.TokenRes, error) {
+		return uc.client.Token(c.Context(), req)
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.JSON(oauthError(err))
+		return
+	}
+
+	uc.log.Info("Issued access token",
+		zap.String("client id", clientID),
+		zap.String("grant type", req.GrantType))
+
+	out := map[string]any{
+		"access_token": res.AccessToken,
+		"token_type":   res.TokenType,
+		"expires_in":   res.ExpiresIn,
+		"scope":        res.Scope,
+	}
+	if res.IdToken != "" {
+		out["id_token"] = res.IdToken
+	}
+	c.JSON(http.StatusOK, out)
+}
+
+func (uc *UsersClient) userinfo(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.userinfo"
+
+	c := service.NewContext(w, r)
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		w.Header().Set("WWW-Authenticate", `Bearer`)
+		c.Problem(apierr.Unauthenticated("Bearer token required"))
+		return
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.OAuthUserInfoRes, error) {
+		return uc.client.OAuthUserInfo(c.Context(), &pb.OAuthUserInfoReq{
+			Token: token,
+		})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		c.Problem(err)
+		return
+	}
+
+	out := map[string]any{"sub": res.Sub}
+	if res.Name != "" {
+		out["name"] = res.Name
+	}
+	if res.Email != "" {
+		out["email"] = res.Email
+		out["email_verified"] = res.EmailVerified
+	}
+	c.JSON(http.StatusOK, out)
+}
+
+func (uc *UsersClient) registerClient(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.registerClient"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		Name         string   `json:"client_name"   validate:"required,max=100"`
+		RedirectURIs []string `json:"redirect_uris" validate:"required,min=1,max=10,unique,dive,url"`
+		Scopes       []string `json:"scopes"        validate:"required,min=1,unique,dive,required"`
+		Confidential bool     `json:"confidential"`
+		userID       string   `validate:"required,uuid"`
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	if err := c.Bind(&req); err != nil {
+		uc.log.Error("Failed to bind request",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	if ui.Delegated() || ui.ActorID != "" {
+		c.Problem(apierr.PermissionDenied("registering clients requires the user's own session"))
+		return
+	}
+	req.userID = ui.UserID
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.RegisterClientRes, error) {
+		return uc.client.RegisterClient(c.Context(), &pb.RegisterClientReq{
+			UserId:       req.userID,
+			Name:         req.Name,
+			RedirectUris: req.RedirectURIs,
+			Scopes:       req.Scopes,
+			Confidential: req.Confidential,
+		})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	uc.log.Info("Registered OAuth client",
+		zap.String("user id", req.userID),
+		zap.String("client id", res.Client.GetId()))
+
+	out := clientJSON(res.Client)
+	if res.ClientSecret != "" {
+		out["client_secret"] = res.ClientSecret
+	}
+	c.JSON(http.StatusCreated, out)
+}
+
+func (uc *UsersClient) listClients(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.listClients"
+
+	c := service.NewContext(w, r)
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	if ui.Delegated() {
+		c.Problem(apierr.PermissionDenied("delegated credentials cannot manage clients"))
+		return
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.ListClientsRes, error) {
+		return uc.client.ListClients(c.Context(), &pb.ListClientsReq{
+			UserId: ui.UserID,
+		})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	clients := make([]map[string]any, len(res.Clients))
+	for i, cl := range res.Clients {
+		clients[i] = clientJSON(cl)
+	}
+	c.JSON(http.StatusOK, map[string]any{"clients": clients})
+}
+
+func (uc *UsersClient) deleteClient(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.deleteClient"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		userID   string `validate:"required,uuid"`
+		clientID string `validate:"required,uuid"`
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	if ui.Delegated() {
+		c.Problem(apierr.PermissionDenied("delegated credentials cannot manage clients"))
+		return
+	}
+	req.userID = ui.UserID
+	req.clientID = chi.URLParam(r, "clientId")
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	if _, err := service.Execute(uc.cb, func() (*pb.DeleteClientRes, error) {
+		return uc.client.DeleteClient(c.Context(), &pb.DeleteClientReq{
+			UserId:   req.userID,
+			ClientId: req.clientID,
+		})
+	}); err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	uc.log.Info("Deleted OAuth client",
+		zap.String("user id", req.userID),
+		zap.String("client id", req.clientID))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func clientJSON(cl *pb.OAuthClient) map[string]any {
+	return map[string]any{
+		"client_id":     cl.GetId(),
+		"client_name":   cl.GetName(),
+		"redirect_uris": cl.GetRedirectUris(),
+		"scopes":        cl.GetScopes(),
+		"confidential":  cl.GetConfidential(),
+		"created_at":    cl.GetCreatedAt().AsTime(),
+	}
+}
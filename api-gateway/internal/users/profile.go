@@ -0,0 +1,236 @@
+package users
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ck "gateway/internal/contextKeys"
+	"gateway/internal/service"
+	"github.com/Votline/3l1/protos/apierr"
+	pb "github.com/Votline/3l1/protos/generated-user"
+)
+
+func (uc *UsersClient) getUser(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.getUser"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		role     string
+		userID   string `validate:"required,len=36"`
+		targetID string `validate:"required,len=36"`
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	req.role = ui.Role
+	req.userID = ui.UserID
+	req.targetID = chi.URLParam(r, "userId")
+	if req.targetID == "" || req.targetID == "me" {
+		req.targetID = req.userID
+	}
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.GetUserRes, error) {
+		return uc.client.GetUser(c.Context(), &pb.GetUserReq{
+			Role:        req.role,
+			Permissions: ui.Perms,
+			UserId:      req.userID,
+			TargetId:    req.targetID,
+		})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, profileJSON(res.User))
+}
+
+func (uc *UsersClient) updateMe(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.updateMe"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		Name        *string `json:"name"         validate:"omitempty,min=2,max=50"`
+		Email       *string `json:"email"        validate:"omitempty,email"`
+		DisplayName *string `json:"display_name" validate:"omitempty,max=100"`
+		Bio         *string `json:"bio"          validate:"omitempty,max=1000"`
+		userID      string
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	if err := c.Bind(&req); err != nil {
+		uc.log.Error("Failed to bind request",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	// a name or email change reaches sign-in, keep it to the user's own session
+	if ui.Delegated() || ui.ActorID != "" {
+		c.Problem(apierr.PermissionDenied("delegated credentials cannot change the profile"))
+		return
+	}
+	req.userID = ui.UserID
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.UpdateUserRes, error) {
+		return uc.client.UpdateUser(c.Context(), &pb.UpdateUserReq{
+			UserId:      req.userID,
+			Name:        req.Name,
+			Email:       req.Email,
+			DisplayName: req.DisplayName,
+			Bio:         req.Bio,
+		})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	uc.log.Info("Successfully updated user",
+		zap.String("user id", req.userID))
+
+	c.JSON(http.StatusOK, profileJSON(res.User))
+}
+
+func (uc *UsersClient) listUsers(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.listUsers"
+
+	c := service.NewContext(w, r)
+	qs := r.URL.Query()
+	req := struct {
+		Query       string `validate:"max=100"`
+		RoleFilter  string `validate:"max=50"`
+		CreatedFrom string `validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+		CreatedTo   string `validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+		Cursor      string `validate:"max=200"`
+		Limit       string `validate:"omitempty,number"`
+		role        string
+	}{
+		Query:       qs.Get("q"),
+		RoleFilter:  qs.Get("role"),
+		CreatedFrom: qs.Get("created_from"),
+		CreatedTo:   qs.Get("created_to"),
+		Cursor:      qs.Get("cursor"),
+		Limit:       qs.Get("limit"),
+	}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	req.role = ui.Role
+	if req.role != "admin" {
+		c.Problem(apierr.PermissionDenied("admin role required"))
+		return
+	}
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	pbReq := &pb.ListUsersReq{
+		Role:        req.role,
+		Permissions: ui.Perms,
+		Query:       req.Query,
+		RoleFilter:  req.RoleFilter,
+		Cursor:      req.Cursor,
+	}
+	if req.Limit != "" {
+		limit, _ := strconv.ParseUint(req.Limit, 10, 32)
+		pbReq.Limit = uint32(limit)
+	}
+	if t, err := time.Parse(time.RFC3339, req.CreatedFrom); err == nil {
+		pbReq.CreatedFrom = timestamppb.New(t)
+	}
+	if t, err := time.Parse(time.RFC3339, req.CreatedTo); err == nil {
+		pbReq.CreatedTo = timestamppb.New(t)
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.ListUsersRes, error) {
+		return uc.client.ListUsers(c.Context(), pbReq)
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	users := make([]map[string]any, len(res.Users))
+	for i, u := range res.Users {
+		users[i] = profileJSON(u)
+	}
+
+	c.JSON(http.StatusOK, map[string]any{
+		"users":       users,
+		"next_cursor": res.NextCursor,
+	})
+}
+
+func profileJSON(u *pb.UserProfile) map[string]any {
+	return map[string]any{
+		"id":             u.GetId(),
+		"name":           u.GetName(),
+		"email":          u.GetEmail(),
+		"role":           u.GetRole(),
+		"email_verified": u.GetEmailVerified(),
+		"totp_enabled":   u.GetTotpEnabled(),
+		"display_name":   u.GetDisplayName(),
+		"bio":            u.GetBio(),
+		"created_at":     u.GetCreatedAt().AsTime().Format(time.RFC3339),
+		"updated_at":     u.GetUpdatedAt().AsTime().Format(time.RFC3339),
+	}
+}
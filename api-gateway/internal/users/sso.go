@@ -0,0 +1,142 @@
+package users
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+
+	ck "gateway/internal/contextKeys"
+	"gateway/internal/service"
+	"github.com/Votline/3l1/protos/apierr"
+	pb "github.com/Votline/3l1/protos/generated-user"
+)
+
+func (uc *UsersClient) ssoLogin(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.ssoLogin"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		provider string `validate:"required,max=50"`
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	req.provider = chi.URLParam(r, "provider")
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.SSOStartRes, error) {
+		return uc.client.SSOStart(c.Context(), &pb.SSOStartReq{
+			Provider: req.provider,
+		})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	uc.log.Info("Redirecting to identity provider",
+		zap.String("provider", req.provider))
+
+	c.SetSSOState(res.State)
+	http.Redirect(w, r, res.AuthUrl, http.StatusFound)
+}
+
+func (uc *UsersClient) ssoCallback(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.ssoCallback"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		provider string `validate:"required,max=50"`
+		code     string `validate:"required,max=2000"`
+		state    string `validate:"required,uuid"`
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	q := r.URL.Query()
+	if idpErr := q.Get("error"); idpErr != "" {
+		uc.log.Error("Identity provider refused login",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.String("error", idpErr),
+			zap.String("description", q.Get("error_description")))
+		c.Problem(apierr.InvalidCredentials("login failed: " + idpErr))
+		return
+	}
+
+	req.provider = chi.URLParam(r, "provider")
+	req.code = q.Get("code")
+	req.state = q.Get("state")
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+	if !c.CheckSSOState(req.state) {
+		uc.log.Warn("SSO state does not match the browser",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.String("provider", req.provider))
+		c.Problem(apierr.InvalidCredentials("login was not started from this browser"))
+		return
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.LogRes, error) {
+		return uc.client.SSOCallback(c.Context(), &pb.SSOCallbackReq{
+			Provider: req.provider,
+			Code:     req.code,
+			State:    req.state,
+		})
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	if res.MfaRequired {
+		uc.log.Info("Second factor required")
+		c.JSON(http.StatusOK, map[string]any{
+			"mfa_required":       true,
+			"mfa_token":          res.MfaToken,
+			"mfa_enrol_required": res.MfaEnrolRequired,
+		})
+		return
+	}
+
+	uc.log.Info("Successfully login",
+		zap.String("provider", req.provider))
+
+	c.SetSession(res.SessionKey)
+	c.JSON(http.StatusOK, map[string]string{
+		"token": res.Token,
+	})
+}
@@ -0,0 +1,214 @@
+package users
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	ck "gateway/internal/contextKeys"
+	"gateway/internal/service"
+	"github.com/Votline/3l1/protos/apierr"
+	pb "github.com/Votline/3l1/protos/generated-user"
+)
+
+// introspect answers RFC 7662 requests from confidential clients.
+func (uc *UsersClient) introspect(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.introspect"
+
+	c := service.NewContext(w, r)
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	w.Header().Set("Cache-Control", "no-store")
+
+	if err := r.ParseForm(); err != nil {
+		c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	clientID, secret, ok := r.BasicAuth()
+	if !ok {
+		clientID = r.PostForm.Get("client_id")
+		secret = r.PostForm.Get("client_secret")
+	}
+
+	req := &pb.IntrospectReq{
+		Token:         r.PostForm.Get("token"),
+		TokenTypeHint: r.PostForm.Get("token_type_hint"),
+		ClientId:      clientID,
+		ClientSecret:  secret,
+	}
+	if err := req.Validate(); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, map[string]string{
+			"error":             "invalid_request",
+			"error_description": err.Error(),
+		})
+		return
+	}
+
+	res, err := service.Execute(uc.cb, func() (*pb.IntrospectRes, error) {
+		return uc.client.IntrospectToken(c.Context(), req)
+	})
+	if err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.JSON(oauthError(err))
+		return
+	}
+
+	if !res.Active {
+		c.JSON(http.StatusOK, map[string]any{"active": false})
+		return
+	}
+
+	out := map[string]any{
+		"active":     true,
+		"token_type": res.TokenType,
+		"sub":        res.Sub,
+		"exp":        res.Exp,
+		"iat":        res.Iat,
+		"jti":        res.Jti,
+		"role":       res.Role,
+	}
+	if res.ClientId != "" {
+		out["client_id"] = res.ClientId
+		out["scope"] = res.Scope
+		out["iss"] = res.Iss
+	}
+	if res.Act != "" {
+		out["act"] = map[string]string{"sub": res.Act}
+	}
+	c.JSON(http.StatusOK, out)
+}
+
+// revoke is the RFC 7009 endpoint, clients revoke their own tokens.
+func (uc *UsersClient) revoke(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.revoke"
+
+	c := service.NewContext(w, r)
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	if err := r.ParseForm(); err != nil {
+		c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	clientID, secret, ok := r.BasicAuth()
+	if !ok {
+		clientID = r.PostForm.Get("client_id")
+		secret = r.PostForm.Get("client_secret")
+	}
+
+	req := &pb.RevokeTokenReq{
+		Token:         r.PostForm.Get("token"),
+		TokenTypeHint: r.PostForm.Get("token_type_hint"),
+		ClientId:      clientID,
+		ClientSecret:  secret,
+	}
+	if err := req.Validate(); err != nil || clientID == "" {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	if _, err := service.Execute(uc.cb, func() (*pb.RevokeTokenRes, error) {
+		return uc.client.RevokeToken(c.Context(), req)
+	}); err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.JSON(oauthError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// revokeToken lets a logged in user kill one of their own tokens, or
+// anyone's with tokens:revoke:any.
+func (uc *UsersClient) revokeToken(w http.ResponseWriter, r *http.Request) {
+	const op = "usersClient.revokeToken"
+
+	c := service.NewContext(w, r)
+	req := struct {
+		Token         string `json:"token"           validate:"required,max=4096"`
+		TokenTypeHint string `json:"token_type_hint" validate:"max=50"`
+		role          string
+		userID        string `validate:"required,uuid"`
+	}{}
+
+	rq, _ := r.Context().Value(ck.ReqKey).(string)
+	if rq == "" {
+		rq = "no-request-id"
+	}
+	uc.log.Info("New request",
+		zap.String("op", op),
+		zap.String("request id", rq))
+
+	if err := c.Bind(&req); err != nil {
+		uc.log.Error("Failed to bind request",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+	ui, _ := r.Context().Value(ck.UserKey).(ck.UserInfo)
+	// the role behind a key or client is wider than what it was granted
+	if ui.Delegated() {
+		c.Problem(apierr.PermissionDenied("delegated credentials cannot revoke tokens"))
+		return
+	}
+	req.role = ui.Role
+	req.userID = ui.UserID
+	if err := c.Validate(req); err != nil {
+		uc.log.Error("Failed to validate request data",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		return
+	}
+
+	if _, err := service.Execute(uc.cb, func() (*pb.RevokeTokenRes, error) {
+		return uc.client.RevokeToken(c.Context(), &pb.RevokeTokenReq{
+			Token:         req.Token,
+			TokenTypeHint: req.TokenTypeHint,
+			Role:          req.role,
+			Permissions:   ui.Perms,
+			UserId:        req.userID,
+		})
+	}); err != nil {
+		uc.log.Error("Rpc request failed",
+			zap.String("op", op),
+			zap.String("request id", rq),
+			zap.Error(err))
+		c.Problem(err)
+		return
+	}
+
+	uc.log.Info("Revoked token",
+		zap.String("user id", req.userID))
+
+	w.WriteHeader(http.StatusNoContent)
+}
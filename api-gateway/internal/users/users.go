@@ -7,14 +7,15 @@ import (
 	"github.com/go-chi/chi"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
-	"github.com/sony/gobreaker/v2"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
+	"gateway/internal/cbreaker"
 	gc "gateway/internal/graceful"
+	"gateway/internal/mdwr"
 	"gateway/internal/service"
-	"gateway/internal/cbreaker"
 
+	"github.com/Votline/3l1/protos/authz"
 	pb "github.com/Votline/3l1/protos/generated-user"
 )
 
@@ -26,17 +27,40 @@ type UsersClient struct {
 	hist    *prometheus.HistogramVec
 	counter *prometheus.CounterVec
 	active  prometheus.Gauge
-	cb *gobreaker.CircuitBreaker[any]
+	cb      *cbreaker.Breaker
+}
+
+// retryPolicies lists the calls that are safe to repeat. Only reads: a
+// write may have landed before its reply was lost, and repeating it can
+// act on state changed in between, e.g. revoke a key issued since.
+var retryPolicies = map[string]service.RetryPolicy{
+	"ExtJWTData":      service.Idempotent,
+	"AuthAPIKey":      service.Idempotent,
+	"AuthAccessToken": service.Idempotent,
+	"OAuthUserInfo":   service.Idempotent,
+	"IntrospectToken": service.Idempotent,
+	"JWKS":            service.Idempotent,
+	"GetUser":         service.Idempotent,
+	"ListUsers":       service.Idempotent,
+	"ListAPIKeys":     service.Idempotent,
+	"ListClients":     service.Idempotent,
+	"ListInvites":     service.Idempotent,
+	"ListRedemptions": service.Idempotent,
+	"ListDeletions":   service.Idempotent,
+	"GetErasure":      service.Idempotent,
+	"ExportUserData":  service.Idempotent,
 }
 
 func New(resTime *prometheus.HistogramVec, log *zap.Logger) service.Service {
+	retrier := service.NewRetrier("users", retryPolicies, log)
 	conn, err := grpc.NewClient(
 		os.Getenv("US_HOST")+":"+os.Getenv("US_PORT"),
-		grpc.WithInsecure())
+		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(retrier.Interceptor()))
 	if err != nil {
 		log.Fatal("User-service connection failed", zap.Error(err))
 	}
-	
+
 	return &UsersClient{
 		log:     log,
 		conn:    conn,
@@ -45,14 +69,56 @@ func New(resTime *prometheus.HistogramVec, log *zap.Logger) service.Service {
 		hist:    resTime,
 		counter: newCounter(),
 		active:  newGauge(),
-		cb: cbreaker.NewCb("UserService", log),
+		cb:      cbreaker.NewCb("users", log),
 	}
 }
 
 func (uc *UsersClient) RegisterRoutes(g chi.Router) {
 	g.Post("/reg", uc.regUser)
 	g.Post("/log", uc.logUser)
-	g.Delete("/del/{delUserId}", uc.delUser)
+	g.Post("/log/mfa", uc.verifyMFA)
+	g.Post("/log/mfa/enroll", uc.enrollTOTP)
+	g.Post("/log/mfa/confirm", uc.confirmTOTP)
+	g.Post("/mfa/enroll", uc.enrollTOTP)
+	g.Post("/mfa/confirm", uc.confirmTOTP)
+	g.With(mdwr.Require(authz.UsersMFAManage)).Put("/mfa/roles/{role}", uc.setMFARole)
+	g.Get("/verify", uc.verifyEmail)
+	g.Post("/verify/resend", uc.resendVerification)
+	g.With(mdwr.RequireAny(authz.UsersDeleteOwn, authz.UsersDeleteAny)).Delete("/del/{delUserId}", uc.delUser)
+	g.With(mdwr.Require(authz.UsersUnlock)).Post("/unlock/{userId}", uc.unlockUser)
+	g.With(mdwr.Require(authz.UsersList)).Get("/list", uc.listUsers)
+	g.Post("/keys", uc.createAPIKey)
+	g.Get("/keys", uc.listAPIKeys)
+	g.Delete("/keys/{keyId}", uc.revokeAPIKey)
+	g.With(mdwr.RequireAny(authz.TokensRevokeOwn, authz.TokensRevokeAny)).Post("/tokens/revoke", uc.revokeToken)
+	g.Get("/sso/{provider}/login", uc.ssoLogin)
+	g.Get("/sso/{provider}/callback", uc.ssoCallback)
+	g.Get("/oauth/.well-known/openid-configuration", uc.discovery)
+	g.Get("/oauth/jwks", uc.jwks)
+	g.Get("/oauth/authorize", uc.authorize)
+	g.Post("/oauth/authorize", uc.authorize)
+	g.Post("/oauth/token", uc.token)
+	g.Post("/oauth/introspect", uc.introspect)
+	g.Post("/oauth/revoke", uc.revoke)
+	g.Get("/oauth/userinfo", uc.userinfo)
+	g.Post("/oauth/userinfo", uc.userinfo)
+	g.Post("/oauth/clients", uc.registerClient)
+	g.Get("/oauth/clients", uc.listClients)
+	g.Delete("/oauth/clients/{clientId}", uc.deleteClient)
+	g.With(mdwr.RequireAny(authz.UsersReadOwn, authz.UsersReadAny)).Get("/me", uc.getUser)
+	g.Patch("/me", uc.updateMe)
+	g.With(mdwr.RequireAny(authz.UsersReadOwn, authz.UsersReadAny)).Get("/{userId}", uc.getUser)
+	g.With(mdwr.Require(authz.UsersRoleSet)).Put("/{userId}/role", uc.setUserRole)
+	g.With(mdwr.Require(authz.UsersImpersonate)).Post("/{userId}/impersonate", uc.impersonate)
+	g.With(mdwr.RequireAny(authz.UsersReadOwn, authz.UsersReadAny)).Get("/{userId}/export", uc.exportUserData)
+	g.With(mdwr.RequireAny(authz.UsersDeleteOwn, authz.UsersDeleteAny)).Post("/{userId}/erase", uc.eraseUser)
+	g.Get("/erasures/{recordId}", uc.getErasure)
+	g.With(mdwr.Require(authz.UsersDeleteAny)).Get("/deletions", uc.listDeletions)
+	g.With(mdwr.Require(authz.UsersInvite)).Post("/invites", uc.createInvite)
+	g.With(mdwr.Require(authz.UsersInvite)).Get("/invites", uc.listInvites)
+	g.With(mdwr.Require(authz.UsersInvite)).Get("/invites/{inviteId}/redemptions", uc.listRedemptions)
+	g.With(mdwr.Require(authz.UsersInvite)).Delete("/invites/{inviteId}", uc.revokeInvite)
+	g.With(mdwr.Require(authz.UsersDeleteAny)).Get("/deletions/{deletionId}", uc.listDeletions)
 	g.Get("/extUserId/{token}", uc.extUserId)
 }
 
@@ -91,5 +157,3 @@ func newGauge() prometheus.Gauge {
 		Help: "Total number of active operations for user service",
 	})
 }
-
-
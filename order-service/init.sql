@@ -14,3 +14,22 @@ CREATE TABLE IF NOT EXISTS orders(
 CREATE INDEX IF NOT EXISTS idx_user_id ON orders(user_id);
 CREATE INDEX IF NOT EXISTS idx_user_role ON orders(user_role);
 CREATE INDEX IF NOT EXISTS idx_id_user_id ON orders(id, user_role);
+
+-- user deletion saga: what was changed per saga, so it can be undone
+-- until user-service finishes the deletion
+CREATE TABLE IF NOT EXISTS user_deletions(
+	saga_id TEXT PRIMARY KEY,
+	user_id TEXT,
+	cancelled BIGINT NOT NULL DEFAULT 0,
+	anonymised BIGINT NOT NULL DEFAULT 0,
+	created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+	finished_at TIMESTAMP,
+	compensated_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS user_deletion_orders(
+	saga_id TEXT NOT NULL REFERENCES user_deletions(saga_id) ON DELETE CASCADE,
+	order_id TEXT NOT NULL,
+	prev_status TEXT NOT NULL,
+	PRIMARY KEY (saga_id, order_id)
+);
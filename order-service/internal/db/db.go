@@ -12,6 +12,9 @@ import (
 	"go.uber.org/zap"
 
 	gc "orders/internal/graceful"
+
+	"github.com/Votline/3l1/protos/apierr"
+	"github.com/Votline/3l1/protos/authz"
 )
 
 type Repo struct {
@@ -92,16 +95,20 @@ func (r *Repo) AddOrder(order *Order) error {
 	return nil
 }
 
-func (r *Repo) OrderInfo(id, userID string) (*Order, error) {
+func (r *Repo) OrderInfo(id, userID string, perms authz.Set) (*Order, error) {
 	const op = "OrderRepository.OrderInfo"
 
-	query, args, err := r.bd.
+	q := r.bd.
 		Select("user_id", "user_role", "status", "target_url",
 			"service_url", "order_type", "created_at", "updated_at").
 		From("orders").
-		Where(sq.Eq{"id": id}).
-		Where(sq.Eq{"user_id": userID}).
-		ToSql()
+		Where(sq.Eq{"id": id})
+
+	if !perms.Has(authz.OrdersReadAny) {
+		q = q.Where(sq.Eq{"user_id": userID})
+	}
+
+	query, args, err := q.ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("%s: create query: %w", op, err)
 	}
@@ -127,18 +134,38 @@ func (r *Repo) getUserInfo(orderID string, tx *sqlx.Tx) (string, string, error)
 	}
 
 	var result struct {
-		userID string `db:"user_id"`
-		userRl string `db:"user_role"`
+		UserID string `db:"user_id"`
+		UserRl string `db:"user_role"`
 	}
 
 	if err := tx.Get(&result, query, args...); err != nil {
 		return "", "", fmt.Errorf("%s: execute tx query: %w", op, err)
 	}
 
-	return result.userID, result.userRl, err
+	return result.UserID, result.UserRl, err
 }
 
-func (r *Repo) DelOrder(id, userID, role string) error {
+func (r *Repo) roleHas(tx *sqlx.Tx, role, perm string) (bool, error) {
+	const op = "OrderRepository.roleHas"
+
+	query, args, err := r.bd.
+		Select("count(*) > 0").
+		From("role_permissions").
+		Where(sq.Eq{"role": role, "permission": perm}).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+
+	var ok bool
+	if err := tx.Get(&ok, query, args...); err != nil {
+		return false, fmt.Errorf("%s: execute tx query: %w", op, err)
+	}
+
+	return ok, nil
+}
+
+func (r *Repo) DelOrder(id, userID string, perms authz.Set) error {
 	const op = "OrderRepository.DelOrder"
 
 	tx, err := r.db.Beginx()
@@ -149,15 +176,25 @@ func (r *Repo) DelOrder(id, userID, role string) error {
 
 	q := r.bd.Delete("orders").Where(sq.Eq{"id": id})
 
-	if role != "admin" {
+	if !perms.Has(authz.OrdersDeleteAny) {
+		if !perms.Has(authz.OrdersDeleteOwn) {
+			return fmt.Errorf("%s: check permission: %w", op, apierr.PermissionDenied("Not allowed to delete orders"))
+		}
 		q = q.Where(sq.Eq{"user_id": userID})
 	} else {
 		delID, delRole, err := r.getUserInfo(id, tx)
 		if err != nil {
 			return fmt.Errorf("%s: get user info: %w", op, err)
 		}
-		if userID != delID && role == delRole {
-			return fmt.Errorf("%s: match role's: %s", op, "Admin cannot delete admin's order")
+		if userID != delID {
+			// peers holding the same power cannot delete each other's orders
+			peer, err := r.roleHas(tx, delRole, authz.OrdersDeleteAny)
+			if err != nil {
+				return fmt.Errorf("%s: check owner role: %w", op, err)
+			}
+			if peer {
+				return fmt.Errorf("%s: check role: %w", op, apierr.PermissionDenied("Cannot delete an order of a user with the same privileges"))
+			}
 		}
 	}
 
@@ -179,3 +216,45 @@ func (r *Repo) DelOrder(id, userID, role string) error {
 
 	return nil
 }
+
+func (r *Repo) UserOrders(userID string) ([]Order, error) {
+	const op = "OrderRepository.UserOrders"
+
+	query, args, err := r.bd.
+		Select("id", "user_id", "user_role", "status", "target_url",
+			"service_url", "order_type", "quantity", "created_at", "updated_at").
+		From("orders").
+		Where(sq.Eq{"user_id": userID}).
+		OrderBy("created_at").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	var orders []Order
+	if err := r.db.Select(&orders, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return orders, nil
+}
+
+func (r *Repo) DelUserOrders(userID string) (int64, error) {
+	const op = "OrderRepository.DelUserOrders"
+
+	query, args, err := r.bd.
+		Delete("orders").
+		Where(sq.Eq{"user_id": userID}).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	res, err := r.db.Exec(query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	n, _ := res.RowsAffected()
+	return n, nil
+}
@@ -0,0 +1,216 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/Votline/3l1/protos/apierr"
+)
+
+// UserDeleted cancels the user's open orders and anonymises all of them,
+// remembering the previous state for CompensateUserDeletion. Repeated
+// calls for the same saga return the first result.
+func (r *Repo) UserDeleted(sagaID, userID string) (int64, int64, error) {
+	const op = "OrderRepository.UserDeleted"
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: create transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := r.bd.
+		Insert("user_deletions").
+		Columns("saga_id", "user_id").
+		Values(sagaID, userID).
+		Suffix("ON CONFLICT (saga_id) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+	res, err := tx.Exec(query, args...)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: execute tx query: %w", op, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		var counts struct {
+			Cancelled  int64 `db:"cancelled"`
+			Anonymised int64 `db:"anonymised"`
+		}
+		if err := tx.Get(&counts,
+			"SELECT cancelled, anonymised FROM user_deletions WHERE saga_id = $1", sagaID); err != nil {
+			return 0, 0, fmt.Errorf("%s: get previous result: %w", op, err)
+		}
+		return counts.Cancelled, counts.Anonymised, nil
+	}
+
+	query, args, err = r.bd.
+		Insert("user_deletion_orders").
+		Columns("saga_id", "order_id", "prev_status").
+		Select(r.bd.
+			Select().
+			Column("?::text", sagaID).
+			Columns("id", "status").
+			From("orders").
+			Where(sq.Eq{"user_id": userID})).
+		ToSql()
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+	if _, err := tx.Exec(query, args...); err != nil {
+		return 0, 0, fmt.Errorf("%s: remember orders: %w", op, err)
+	}
+
+	query, args, err = r.bd.
+		Update("orders").
+		Set("status", "cancelled").
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"user_id": userID}).
+		Where(sq.NotEq{"status": []string{"done", "cancelled"}}).
+		ToSql()
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+	res, err = tx.Exec(query, args...)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: cancel orders: %w", op, err)
+	}
+	cancelled, _ := res.RowsAffected()
+
+	query, args, err = r.bd.
+		Update("orders").
+		Set("user_id", "deleted:"+sagaID).
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"user_id": userID}).
+		ToSql()
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+	res, err = tx.Exec(query, args...)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: anonymise orders: %w", op, err)
+	}
+	anonymised, _ := res.RowsAffected()
+
+	query, args, err = r.bd.
+		Update("user_deletions").
+		Set("cancelled", cancelled).
+		Set("anonymised", anonymised).
+		Where(sq.Eq{"saga_id": sagaID}).
+		ToSql()
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+	if _, err := tx.Exec(query, args...); err != nil {
+		return 0, 0, fmt.Errorf("%s: save result: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, 0, fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return cancelled, anonymised, nil
+}
+
+// FinishUserDeletion drops the undo data, after which the orders can no
+// longer be traced back to the user.
+func (r *Repo) FinishUserDeletion(sagaID string) error {
+	const op = "OrderRepository.FinishUserDeletion"
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("%s: create transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := r.bd.
+		Update("user_deletions").
+		Set("user_id", nil).
+		Set("finished_at", sq.Expr("COALESCE(finished_at, NOW())")).
+		Where(sq.Eq{"saga_id": sagaID, "compensated_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+	if _, err := tx.Exec(query, args...); err != nil {
+		return fmt.Errorf("%s: execute tx query: %w", op, err)
+	}
+
+	query, args, err = r.bd.
+		Delete("user_deletion_orders").
+		Where(sq.Eq{"saga_id": sagaID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+	if _, err := tx.Exec(query, args...); err != nil {
+		return fmt.Errorf("%s: execute tx query: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return nil
+}
+
+// CompensateUserDeletion restores the orders changed by UserDeleted. A
+// saga that never reached this service has nothing to undo.
+func (r *Repo) CompensateUserDeletion(sagaID string) error {
+	const op = "OrderRepository.CompensateUserDeletion"
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("%s: create transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var state struct {
+		UserID      sql.NullString `db:"user_id"`
+		Finished    bool           `db:"finished"`
+		Compensated bool           `db:"compensated"`
+	}
+	err = tx.Get(&state, `SELECT user_id, finished_at IS NOT NULL AS finished,
+		compensated_at IS NOT NULL AS compensated
+		FROM user_deletions WHERE saga_id = $1 FOR UPDATE`, sagaID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%s: get saga: %w", op, err)
+	}
+	if state.Compensated {
+		return nil
+	}
+	if state.Finished {
+		return fmt.Errorf("%s: check state: %w", op, apierr.Precondition("Deletion already finished"))
+	}
+
+	if _, err := tx.Exec(`UPDATE orders o
+		SET status = l.prev_status, user_id = $2, updated_at = NOW()
+		FROM user_deletion_orders l
+		WHERE l.saga_id = $1 AND o.id = l.order_id`, sagaID, state.UserID.String); err != nil {
+		return fmt.Errorf("%s: restore orders: %w", op, err)
+	}
+
+	query, args, err := r.bd.
+		Update("user_deletions").
+		Set("compensated_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"saga_id": sagaID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+	if _, err := tx.Exec(query, args...); err != nil {
+		return fmt.Errorf("%s: execute tx query: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return nil
+}
@@ -17,6 +17,8 @@ import (
 	"orders/internal/db"
 	gc "orders/internal/graceful"
 
+	"github.com/Votline/3l1/protos/apierr"
+	"github.com/Votline/3l1/protos/authz"
 	pb "github.com/Votline/3l1/protos/generated-order"
 )
 
@@ -33,7 +35,12 @@ func main() {
 		log.Fatal("Couldn't listen tcp order-service port", zap.Error(err))
 	}
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(grpc.UnaryInterceptor(apierr.UnaryServerInterceptor(
+		func(ctx context.Context, method string, err error) {
+			log.Error("Rpc failed",
+				zap.String("method", method),
+				zap.Error(err))
+		})))
 	srv := orderservice{log: log, repo: db.NewRepo(log)}
 	pb.RegisterOrderServiceServer(s, &srv)
 	go s.Serve(lis)
@@ -67,6 +74,11 @@ func (os *orderservice) AddOrder(ctx context.Context, req *pb.AddOrderReq) (*pb.
 		return nil, fmt.Errorf("%s validate: %w", op, err)
 	}
 
+	perms := authz.New(req.GetPermissions())
+	if !perms.Has(authz.OrdersCreate) {
+		return nil, fmt.Errorf("%s: check permission: %w", op, apierr.PermissionDenied("Not allowed to create orders"))
+	}
+
 	order := &db.Order{
 		ID:         uuid.New().String(),
 		UserID:     req.GetUserId(),
@@ -94,7 +106,9 @@ func (os *orderservice) OrderInfo(ctx context.Context, req *pb.OrderInfoReq) (*p
 	id := req.GetId()
 	userID := req.GetUserId()
 
-	order, err := os.repo.OrderInfo(id, userID)
+	perms := authz.New(req.GetPermissions())
+
+	order, err := os.repo.OrderInfo(id, userID, perms)
 	if err != nil {
 		return nil, fmt.Errorf("%s: order info: %w", op, err)
 	}
@@ -120,9 +134,9 @@ func (os *orderservice) DelOrder(ctx context.Context, req *pb.DelOrderReq) (*pb.
 
 	id := req.GetId()
 	userID := req.GetUserId()
-	role := req.GetRole()
+	perms := authz.New(req.GetPermissions())
 
-	if err := os.repo.DelOrder(id, userID, role); err != nil {
+	if err := os.repo.DelOrder(id, userID, perms); err != nil {
 		return nil, fmt.Errorf("%s: delete order: %w", op, err)
 	}
 
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Votline/3l1/protos/apierr"
+	pb "github.com/Votline/3l1/protos/generated-order"
+)
+
+func (os *orderservice) UserOrders(ctx context.Context, req *pb.UserOrdersReq) (*pb.UserOrdersRes, error) {
+	const op = "OrderService.UserOrders"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	orders, err := os.repo.UserOrders(req.GetUserId())
+	if err != nil {
+		return nil, fmt.Errorf("%s: user orders: %w", op, err)
+	}
+
+	res := &pb.UserOrdersRes{Orders: make([]*pb.Order, 0, len(orders))}
+	for _, o := range orders {
+		res.Orders = append(res.Orders, &pb.Order{
+			Id:         o.ID,
+			UserRole:   o.UserRl,
+			Status:     o.Status,
+			TargetUrl:  o.TargetURL,
+			ServiceUrl: o.ServiceURL,
+			OrderType:  o.OrderType,
+			Quantity:   o.Quantity,
+			CreatedAt:  timestamppb.New(o.CreatedAt),
+			UpdatedAt:  timestamppb.New(o.UpdatedAt),
+		})
+	}
+
+	return res, nil
+}
+
+func (os *orderservice) EraseUserOrders(ctx context.Context, req *pb.EraseUserOrdersReq) (*pb.EraseUserOrdersRes, error) {
+	const op = "OrderService.EraseUserOrders"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	n, err := os.repo.DelUserOrders(req.GetUserId())
+	if err != nil {
+		return nil, fmt.Errorf("%s: delete orders: %w", op, err)
+	}
+
+	return &pb.EraseUserOrdersRes{Erased: n}, nil
+}
+
+func (os *orderservice) UserDeleted(ctx context.Context, req *pb.UserDeletionReq) (*pb.UserDeletedRes, error) {
+	const op = "OrderService.UserDeleted"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+	if req.GetUserId() == "" {
+		return nil, fmt.Errorf("%s: validate: %w", op, apierr.Invalid("user_id is required"))
+	}
+
+	cancelled, anonymised, err := os.repo.UserDeleted(req.GetSagaId(), req.GetUserId())
+	if err != nil {
+		return nil, fmt.Errorf("%s: apply deletion: %w", op, err)
+	}
+
+	os.log.Info("Applied user deletion",
+		zap.String("op", op),
+		zap.String("saga id", req.GetSagaId()),
+		zap.Int64("cancelled", cancelled),
+		zap.Int64("anonymised", anonymised))
+
+	return &pb.UserDeletedRes{Cancelled: cancelled, Anonymised: anonymised}, nil
+}
+
+func (os *orderservice) FinishUserDeletion(ctx context.Context, req *pb.UserDeletionReq) (*pb.UserDeletionRes, error) {
+	const op = "OrderService.FinishUserDeletion"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	if err := os.repo.FinishUserDeletion(req.GetSagaId()); err != nil {
+		return nil, fmt.Errorf("%s: finish deletion: %w", op, err)
+	}
+
+	return &pb.UserDeletionRes{}, nil
+}
+
+func (os *orderservice) CompensateUserDeletion(ctx context.Context, req *pb.UserDeletionReq) (*pb.UserDeletionRes, error) {
+	const op = "OrderService.CompensateUserDeletion"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	if err := os.repo.CompensateUserDeletion(req.GetSagaId()); err != nil {
+		return nil, fmt.Errorf("%s: compensate deletion: %w", op, err)
+	}
+
+	os.log.Warn("Compensated user deletion",
+		zap.String("op", op),
+		zap.String("saga id", req.GetSagaId()))
+
+	return &pb.UserDeletionRes{}, nil
+}
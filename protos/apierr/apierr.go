@@ -0,0 +1,215 @@
+// Package apierr turns service errors into gRPC statuses the gateway can
+// translate. Every status carries an ErrorInfo with a stable reason, so
+// clients get a code that does not change with the message text.
+package apierr
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// Domain is the ErrorInfo domain of errors raised by our services.
+const Domain = "3l1"
+
+// Stable reasons, sent to clients as the problem code.
+const (
+	ReasonInvalidArgument    = "INVALID_ARGUMENT"
+	ReasonValidationFailed   = "VALIDATION_FAILED"
+	ReasonNotFound           = "NOT_FOUND"
+	ReasonAlreadyExists      = "ALREADY_EXISTS"
+	ReasonReferenceMissing   = "REFERENCE_MISSING"
+	ReasonFailedPrecondition = "FAILED_PRECONDITION"
+	ReasonPermissionDenied   = "PERMISSION_DENIED"
+	ReasonUnauthenticated    = "UNAUTHENTICATED"
+	ReasonInvalidCredentials = "INVALID_CREDENTIALS"
+	ReasonRateLimited        = "RATE_LIMITED"
+	ReasonUnavailable        = "UNAVAILABLE"
+	ReasonTimeout            = "TIMEOUT"
+	ReasonCanceled           = "CANCELED"
+	ReasonUnimplemented      = "UNIMPLEMENTED"
+	ReasonInternal           = "INTERNAL"
+)
+
+// Reasons specific to one service.
+const (
+	ReasonUsernameTaken    = "USERNAME_TAKEN"
+	ReasonEmailTaken       = "EMAIL_TAKEN"
+	ReasonEmailNotVerified = "EMAIL_NOT_VERIFIED"
+)
+
+// New builds a status with an ErrorInfo detail followed by details.
+func New(code codes.Code, reason, msg string, details ...protoadapt.MessageV1) *status.Status {
+	st := status.New(code, msg)
+	details = append([]protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: Domain,
+	}}, details...)
+	if d, err := st.WithDetails(details...); err == nil {
+		return d
+	}
+	return st
+}
+
+// Reason returns the ErrorInfo reason of st, or one derived from its
+// code when the status came without details.
+func Reason(st *status.Status) string {
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.GetReason() != "" {
+			return info.GetReason()
+		}
+	}
+	return CodeReason(st.Code())
+}
+
+// CodeReason is the fallback reason of a bare status code.
+func CodeReason(code codes.Code) string {
+	switch code {
+	case codes.InvalidArgument, codes.OutOfRange:
+		return ReasonInvalidArgument
+	case codes.NotFound:
+		return ReasonNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return ReasonAlreadyExists
+	case codes.FailedPrecondition:
+		return ReasonFailedPrecondition
+	case codes.PermissionDenied:
+		return ReasonPermissionDenied
+	case codes.Unauthenticated:
+		return ReasonUnauthenticated
+	case codes.ResourceExhausted:
+		return ReasonRateLimited
+	case codes.Unavailable:
+		return ReasonUnavailable
+	case codes.DeadlineExceeded:
+		return ReasonTimeout
+	case codes.Canceled:
+		return ReasonCanceled
+	case codes.Unimplemented:
+		return ReasonUnimplemented
+	}
+	return ReasonInternal
+}
+
+// validationError is implemented by the protoc-gen-validate errors.
+// Cause holds the error of an embedded message.
+type validationError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// multiError is what ValidateAll returns.
+type multiError interface {
+	AllErrors() []error
+}
+
+// sqlStateError is implemented by *pq.Error, matched by interface so
+// this package stays free of the driver.
+type sqlStateError interface {
+	SQLState() string
+}
+
+// Convert maps err to the status returned to the caller. A status in
+// the chain, e.g. from a call to another service, passes unchanged.
+// Messages of mapped errors are written here, so driver details such
+// as constraint names stay in the service. Anything unrecognised
+// becomes Unknown with a generic message, the interceptor's report
+// gets the real one.
+func Convert(err error) *status.Status {
+	var se interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &se) {
+		return se.GRPCStatus()
+	}
+
+	if br := badRequest(err); br != nil {
+		v := br.FieldViolations[0]
+		return New(codes.InvalidArgument, ReasonValidationFailed,
+			"invalid "+v.GetField()+": "+v.GetDescription(), br)
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return New(codes.NotFound, ReasonNotFound, "not found")
+	}
+
+	var sqlErr sqlStateError
+	if errors.As(err, &sqlErr) {
+		switch state := sqlErr.SQLState(); {
+		case state == "23505":
+			return New(codes.AlreadyExists, ReasonAlreadyExists, "already exists")
+		case state == "23503":
+			return New(codes.FailedPrecondition, ReasonReferenceMissing, "referenced record does not exist")
+		case state == "23502" || state == "23514" || strings.HasPrefix(state, "22"):
+			return New(codes.InvalidArgument, ReasonInvalidArgument, "invalid value")
+		}
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return New(codes.Canceled, ReasonCanceled, "request canceled")
+	case errors.Is(err, context.DeadlineExceeded):
+		return New(codes.DeadlineExceeded, ReasonTimeout, "deadline exceeded")
+	}
+	return New(codes.Unknown, ReasonInternal, "internal error")
+}
+
+// badRequest lists the field violations of a failed Validate or
+// ValidateAll, nil if err is not one.
+func badRequest(err error) *errdetails.BadRequest {
+	var errs []error
+	var me multiError
+	if errors.As(err, &me) {
+		errs = me.AllErrors()
+	} else {
+		errs = []error{err}
+	}
+
+	br := &errdetails.BadRequest{}
+	for _, e := range errs {
+		var ve validationError
+		if !errors.As(e, &ve) {
+			continue
+		}
+		// nested messages report their own field, join them into a path
+		path := []string{ve.Field()}
+		for {
+			var inner validationError
+			if cause := ve.Cause(); cause == nil || !errors.As(cause, &inner) {
+				break
+			}
+			ve = inner
+			path = append(path, ve.Field())
+		}
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       strings.Join(path, "."),
+			Description: ve.Reason(),
+		})
+	}
+	if len(br.FieldViolations) == 0 {
+		return nil
+	}
+	return br
+}
+
+// UnaryServerInterceptor converts handler errors with Convert. report,
+// if set, sees the original error of every call that failed.
+func UnaryServerInterceptor(report func(ctx context.Context, method string, err error)) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (any, error) {
+
+		res, err := handler(ctx, req)
+		if err == nil {
+			return res, nil
+		}
+		if report != nil {
+			report(ctx, info.FullMethod, err)
+		}
+		return nil, Convert(err).Err()
+	}
+}
@@ -0,0 +1,126 @@
+package apierr
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fieldError and multi mimic what protoc-gen-validate generates.
+type fieldError struct {
+	field, reason string
+	cause         error
+}
+
+func (e fieldError) Error() string  { return e.field + ": " + e.reason }
+func (e fieldError) Field() string  { return e.field }
+func (e fieldError) Reason() string { return e.reason }
+func (e fieldError) Cause() error   { return e.cause }
+
+type multi []error
+
+func (m multi) Error() string      { return "multiple errors" }
+func (m multi) AllErrors() []error { return m }
+
+type sqlErr string
+
+func (e sqlErr) Error() string    { return "pq: " + string(e) }
+func (e sqlErr) SQLState() string { return string(e) }
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantCode   codes.Code
+		wantReason string
+		wantMsg    string
+		wantFields []string
+	}{
+		{"domain error", fmt.Errorf("op: %w", NotFound("user not found")),
+			codes.NotFound, ReasonNotFound, "user not found", nil},
+		{"custom reason", fmt.Errorf("op: %w", Conflict("taken").WithReason(ReasonEmailTaken)),
+			codes.AlreadyExists, ReasonEmailTaken, "taken", nil},
+		{"status from another service", fmt.Errorf("op: %w", status.Error(codes.Unavailable, "down")),
+			codes.Unavailable, ReasonUnavailable, "down", nil},
+		{"validation", fmt.Errorf("op validate: %w", fieldError{field: "Email", reason: "bad"}),
+			codes.InvalidArgument, ReasonValidationFailed, "invalid Email: bad", []string{"Email"}},
+		{"nested validation", fieldError{field: "Filter", reason: "embedded",
+			cause: fieldError{field: "Role", reason: "bad"}},
+			codes.InvalidArgument, ReasonValidationFailed, "invalid Filter.Role: bad", []string{"Filter.Role"}},
+		{"validate all", multi{fieldError{field: "A", reason: "x"}, fieldError{field: "B", reason: "y"}},
+			codes.InvalidArgument, ReasonValidationFailed, "invalid A: x", []string{"A", "B"}},
+		{"no rows", fmt.Errorf("op: %w", sql.ErrNoRows),
+			codes.NotFound, ReasonNotFound, "not found", nil},
+		{"unique", fmt.Errorf("op: %w", sqlErr("23505")),
+			codes.AlreadyExists, ReasonAlreadyExists, "already exists", nil},
+		{"foreign key", sqlErr("23503"),
+			codes.FailedPrecondition, ReasonReferenceMissing, "referenced record does not exist", nil},
+		{"not null", sqlErr("23502"),
+			codes.InvalidArgument, ReasonInvalidArgument, "invalid value", nil},
+		{"check", sqlErr("23514"),
+			codes.InvalidArgument, ReasonInvalidArgument, "invalid value", nil},
+		{"data exception", sqlErr("22P02"),
+			codes.InvalidArgument, ReasonInvalidArgument, "invalid value", nil},
+		{"other sql state", sqlErr("40001"),
+			codes.Unknown, ReasonInternal, "internal error", nil},
+		{"canceled", fmt.Errorf("op: %w", context.Canceled),
+			codes.Canceled, ReasonCanceled, "request canceled", nil},
+		{"deadline", context.DeadlineExceeded,
+			codes.DeadlineExceeded, ReasonTimeout, "deadline exceeded", nil},
+		{"untyped is hidden", errors.New("dial tcp 10.0.0.5:5432: connection refused"),
+			codes.Unknown, ReasonInternal, "internal error", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := Convert(tt.err)
+			if st.Code() != tt.wantCode || st.Message() != tt.wantMsg {
+				t.Errorf("Convert = %s %q, want %s %q", st.Code(), st.Message(), tt.wantCode, tt.wantMsg)
+			}
+			if r := Reason(st); r != tt.wantReason {
+				t.Errorf("reason = %s, want %s", r, tt.wantReason)
+			}
+
+			var fields []string
+			for _, d := range st.Details() {
+				if br, ok := d.(*errdetails.BadRequest); ok {
+					for _, v := range br.GetFieldViolations() {
+						fields = append(fields, v.GetField())
+					}
+				}
+			}
+			if fmt.Sprint(fields) != fmt.Sprint(tt.wantFields) {
+				t.Errorf("fields = %v, want %v", fields, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestReasonFallsBackToCode(t *testing.T) {
+	tests := []struct {
+		code codes.Code
+		want string
+	}{
+		{codes.InvalidArgument, ReasonInvalidArgument},
+		{codes.OutOfRange, ReasonInvalidArgument},
+		{codes.NotFound, ReasonNotFound},
+		{codes.Aborted, ReasonAlreadyExists},
+		{codes.PermissionDenied, ReasonPermissionDenied},
+		{codes.Unauthenticated, ReasonUnauthenticated},
+		{codes.ResourceExhausted, ReasonRateLimited},
+		{codes.Unavailable, ReasonUnavailable},
+		{codes.DeadlineExceeded, ReasonTimeout},
+		{codes.Internal, ReasonInternal},
+		{codes.DataLoss, ReasonInternal},
+	}
+	for _, tt := range tests {
+		if got := Reason(status.New(tt.code, "bare")); got != tt.want {
+			t.Errorf("Reason(%s) = %s, want %s", tt.code, got, tt.want)
+		}
+	}
+}
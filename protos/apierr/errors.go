@@ -0,0 +1,76 @@
+package apierr
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Error is a domain error of a service. Its message is client facing,
+// so wrap it with %w to add context for the logs:
+//
+//	fmt.Errorf("%s: check password: %w", op, apierr.InvalidCredentials("Invalid password"))
+type Error struct {
+	Code   codes.Code
+	Reason string
+	Msg    string
+}
+
+func (e *Error) Error() string {
+	return e.Msg
+}
+
+// GRPCStatus lets Convert and the grpc package find the status anywhere
+// in a wrapped chain.
+func (e *Error) GRPCStatus() *status.Status {
+	return New(e.Code, e.Reason, e.Msg)
+}
+
+// WithReason returns a copy with a more specific reason, for clients
+// that need to tell apart errors of the same kind.
+func (e *Error) WithReason(reason string) *Error {
+	c := *e
+	c.Reason = reason
+	return &c
+}
+
+// NotFound is a missing resource, or one the caller may not know about.
+func NotFound(msg string) *Error {
+	return &Error{Code: codes.NotFound, Reason: ReasonNotFound, Msg: msg}
+}
+
+// Conflict is a resource that already exists or a state that was
+// already reached.
+func Conflict(msg string) *Error {
+	return &Error{Code: codes.AlreadyExists, Reason: ReasonAlreadyExists, Msg: msg}
+}
+
+// PermissionDenied is a known caller that may not do this.
+func PermissionDenied(msg string) *Error {
+	return &Error{Code: codes.PermissionDenied, Reason: ReasonPermissionDenied, Msg: msg}
+}
+
+// InvalidCredentials is a password, code, key or token that did not
+// check out.
+func InvalidCredentials(msg string) *Error {
+	return &Error{Code: codes.Unauthenticated, Reason: ReasonInvalidCredentials, Msg: msg}
+}
+
+// Invalid is a request that is wrong whatever the state.
+func Invalid(msg string) *Error {
+	return &Error{Code: codes.InvalidArgument, Reason: ReasonInvalidArgument, Msg: msg}
+}
+
+// Precondition is a request that is fine but not in the current state.
+func Precondition(msg string) *Error {
+	return &Error{Code: codes.FailedPrecondition, Reason: ReasonFailedPrecondition, Msg: msg}
+}
+
+// Limited is a caller that has to wait before trying again.
+func Limited(msg string) *Error {
+	return &Error{Code: codes.ResourceExhausted, Reason: ReasonRateLimited, Msg: msg}
+}
+
+// Unauthenticated is a request that came without usable credentials.
+func Unauthenticated(msg string) *Error {
+	return &Error{Code: codes.Unauthenticated, Reason: ReasonUnauthenticated, Msg: msg}
+}
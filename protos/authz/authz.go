@@ -0,0 +1,80 @@
+// Package authz is the permission check shared by the gateway and the
+// services. Permissions are "resource:action" or "resource:action:scope",
+// where the "any" scope implies "own".
+package authz
+
+import (
+	"slices"
+	"strings"
+)
+
+// Scoped actions, checked with CanOn.
+const (
+	UsersRead    = "users:read"
+	UsersDelete  = "users:delete"
+	OrdersRead   = "orders:read"
+	OrdersDelete = "orders:delete"
+	TokensRevoke = "tokens:revoke"
+)
+
+const (
+	UsersReadOwn     = UsersRead + ":own"
+	UsersReadAny     = UsersRead + ":any"
+	UsersList        = "users:list"
+	UsersDeleteOwn   = UsersDelete + ":own"
+	UsersDeleteAny   = UsersDelete + ":any"
+	UsersRoleSet     = "users:role:set"
+	UsersUnlock      = "users:unlock"
+	UsersMFAManage   = "users:mfa:manage"
+	UsersImpersonate = "users:impersonate"
+	UsersInvite      = "users:invite"
+	OrdersCreate     = "orders:create"
+	OrdersReadOwn    = OrdersRead + ":own"
+	OrdersReadAny    = OrdersRead + ":any"
+	OrdersDeleteOwn  = OrdersDelete + ":own"
+	OrdersDeleteAny  = OrdersDelete + ":any"
+	TokensRevokeOwn  = TokensRevoke + ":own"
+	TokensRevokeAny  = TokensRevoke + ":any"
+	GatewayManage    = "gateway:manage"
+)
+
+type Set map[string]struct{}
+
+func New(perms []string) Set {
+	s := make(Set, len(perms))
+	for _, p := range perms {
+		s[p] = struct{}{}
+	}
+	return s
+}
+
+// Has matches exact permissions as well as "*" and "resource:*".
+func (s Set) Has(perm string) bool {
+	if _, ok := s[perm]; ok {
+		return true
+	}
+	if _, ok := s["*"]; ok {
+		return true
+	}
+	resource, _, _ := strings.Cut(perm, ":")
+	_, ok := s[resource+":*"]
+	return ok
+}
+
+// CanOn checks "resource:action" against a target that the caller
+// owns or not.
+func (s Set) CanOn(action string, own bool) bool {
+	if s.Has(action + ":any") {
+		return true
+	}
+	return own && s.Has(action+":own")
+}
+
+func (s Set) List() []string {
+	perms := make([]string, 0, len(s))
+	for p := range s {
+		perms = append(perms, p)
+	}
+	slices.Sort(perms)
+	return perms
+}
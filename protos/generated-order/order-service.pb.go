@@ -31,6 +31,7 @@ type AddOrderReq struct {
 	OrderType     string                 `protobuf:"bytes,4,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
 	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	ServiceUrl    string                 `protobuf:"bytes,6,opt,name=service_url,json=serviceUrl,proto3" json:"service_url,omitempty"`
+	Permissions   []string               `protobuf:"bytes,7,rep,name=permissions,proto3" json:"permissions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -107,6 +108,13 @@ func (x *AddOrderReq) GetServiceUrl() string {
 	return ""
 }
 
+func (x *AddOrderReq) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
 type AddOrderRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -155,6 +163,7 @@ type OrderInfoReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -203,6 +212,13 @@ func (x *OrderInfoReq) GetUserId() string {
 	return ""
 }
 
+func (x *OrderInfoReq) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
 type OrderInfoRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -308,6 +324,7 @@ type DelOrderReq struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Permissions   []string               `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -363,6 +380,13 @@ func (x *DelOrderReq) GetRole() string {
 	return ""
 }
 
+func (x *DelOrderReq) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
 type DelOrderRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -399,29 +423,455 @@ func (*DelOrderRes) Descriptor() ([]byte, []int) {
 	return file_order_service_proto_rawDescGZIP(), []int{5}
 }
 
+type Order struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserRole      string                 `protobuf:"bytes,2,opt,name=user_role,json=userRole,proto3" json:"user_role,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	TargetUrl     string                 `protobuf:"bytes,4,opt,name=target_url,json=targetUrl,proto3" json:"target_url,omitempty"`
+	ServiceUrl    string                 `protobuf:"bytes,5,opt,name=service_url,json=serviceUrl,proto3" json:"service_url,omitempty"`
+	OrderType     string                 `protobuf:"bytes,6,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	Quantity      int32                  `protobuf:"varint,7,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_order_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Order) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Order) GetUserRole() string {
+	if x != nil {
+		return x.UserRole
+	}
+	return ""
+}
+
+func (x *Order) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Order) GetTargetUrl() string {
+	if x != nil {
+		return x.TargetUrl
+	}
+	return ""
+}
+
+func (x *Order) GetServiceUrl() string {
+	if x != nil {
+		return x.ServiceUrl
+	}
+	return ""
+}
+
+func (x *Order) GetOrderType() string {
+	if x != nil {
+		return x.OrderType
+	}
+	return ""
+}
+
+func (x *Order) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Order) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Order) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type UserOrdersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserOrdersReq) Reset() {
+	*x = UserOrdersReq{}
+	mi := &file_order_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserOrdersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserOrdersReq) ProtoMessage() {}
+
+func (x *UserOrdersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserOrdersReq.ProtoReflect.Descriptor instead.
+func (*UserOrdersReq) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UserOrdersReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UserOrdersRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserOrdersRes) Reset() {
+	*x = UserOrdersRes{}
+	mi := &file_order_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserOrdersRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserOrdersRes) ProtoMessage() {}
+
+func (x *UserOrdersRes) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserOrdersRes.ProtoReflect.Descriptor instead.
+func (*UserOrdersRes) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UserOrdersRes) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type EraseUserOrdersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseUserOrdersReq) Reset() {
+	*x = EraseUserOrdersReq{}
+	mi := &file_order_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseUserOrdersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseUserOrdersReq) ProtoMessage() {}
+
+func (x *EraseUserOrdersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseUserOrdersReq.ProtoReflect.Descriptor instead.
+func (*EraseUserOrdersReq) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EraseUserOrdersReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type EraseUserOrdersRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Erased        int64                  `protobuf:"varint,1,opt,name=erased,proto3" json:"erased,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseUserOrdersRes) Reset() {
+	*x = EraseUserOrdersRes{}
+	mi := &file_order_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseUserOrdersRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseUserOrdersRes) ProtoMessage() {}
+
+func (x *EraseUserOrdersRes) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseUserOrdersRes.ProtoReflect.Descriptor instead.
+func (*EraseUserOrdersRes) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EraseUserOrdersRes) GetErased() int64 {
+	if x != nil {
+		return x.Erased
+	}
+	return 0
+}
+
+type UserDeletionReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SagaId        string                 `protobuf:"bytes,1,opt,name=saga_id,json=sagaId,proto3" json:"saga_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDeletionReq) Reset() {
+	*x = UserDeletionReq{}
+	mi := &file_order_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDeletionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDeletionReq) ProtoMessage() {}
+
+func (x *UserDeletionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDeletionReq.ProtoReflect.Descriptor instead.
+func (*UserDeletionReq) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UserDeletionReq) GetSagaId() string {
+	if x != nil {
+		return x.SagaId
+	}
+	return ""
+}
+
+func (x *UserDeletionReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UserDeletedRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cancelled     int64                  `protobuf:"varint,1,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	Anonymised    int64                  `protobuf:"varint,2,opt,name=anonymised,proto3" json:"anonymised,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDeletedRes) Reset() {
+	*x = UserDeletedRes{}
+	mi := &file_order_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDeletedRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDeletedRes) ProtoMessage() {}
+
+func (x *UserDeletedRes) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDeletedRes.ProtoReflect.Descriptor instead.
+func (*UserDeletedRes) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UserDeletedRes) GetCancelled() int64 {
+	if x != nil {
+		return x.Cancelled
+	}
+	return 0
+}
+
+func (x *UserDeletedRes) GetAnonymised() int64 {
+	if x != nil {
+		return x.Anonymised
+	}
+	return 0
+}
+
+type UserDeletionRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDeletionRes) Reset() {
+	*x = UserDeletionRes{}
+	mi := &file_order_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDeletionRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDeletionRes) ProtoMessage() {}
+
+func (x *UserDeletionRes) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDeletionRes.ProtoReflect.Descriptor instead.
+func (*UserDeletionRes) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{13}
+}
+
 var File_order_service_proto protoreflect.FileDescriptor
 
 const file_order_service_proto_rawDesc = "" +
 	"\n" +
-	"\x13order-service.proto\x12\x06orders\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\x9e\x02\n" +
+	"\x13order-service.proto\x12\x06orders\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xb1\x02\n" +
 	"\vAddOrderReq\x12!\n" +
-	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x125\n" +
-	"\tuser_role\x18\x02 \x01(\tB\x18\xfaB\x15r\x13R\x05adminR\x03devR\x05guestR\buserRole\x12'\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12&\n" +
+	"\tuser_role\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\buserRole\x12'\n" +
 	"\n" +
 	"target_url\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01R\ttargetUrl\x12<\n" +
 	"\n" +
 	"order_type\x18\x04 \x01(\tB\x1d\xfaB\x1ar\x18R\bcommentsR\x05likesR\x05viewsR\torderType\x12#\n" +
 	"\bquantity\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\bquantity\x12)\n" +
 	"\vservice_url\x18\x06 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01R\n" +
-	"serviceUrl\"'\n" +
+	"serviceUrl\x12 \n" +
+	"\vpermissions\x18\a \x03(\tR\vpermissions\"'\n" +
 	"\vAddOrderRes\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\"K\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\"m\n" +
 	"\fOrderInfoReq\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12!\n" +
-	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\"\xc0\x03\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\"\xb1\x03\n" +
 	"\fOrderInfoRes\x12!\n" +
-	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x125\n" +
-	"\tuser_role\x18\x02 \x01(\tB\x18\xfaB\x15r\x13R\x05adminR\x03devR\x05guestR\buserRole\x12:\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12&\n" +
+	"\tuser_role\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\buserRole\x12:\n" +
 	"\x06status\x18\x03 \x01(\tB\"\xfaB\x1fr\x1dR\x04doneR\tcancelledR\n" +
 	"processingR\x06status\x12'\n" +
 	"\n" +
@@ -433,16 +883,55 @@ const file_order_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\tcreatedAt\x12C\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\tupdatedAt\"x\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\tupdatedAt\"\x8b\x01\n" +
 	"\vDelOrderReq\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12!\n" +
-	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12,\n" +
-	"\x04role\x18\x03 \x01(\tB\x18\xfaB\x15r\x13R\x05adminR\x03devR\x05guestR\x04role\"\r\n" +
-	"\vDelOrderRes2\xb3\x01\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12\x1d\n" +
+	"\x04role\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04role\x12 \n" +
+	"\vpermissions\x18\x04 \x03(\tR\vpermissions\"\r\n" +
+	"\vDelOrderRes\"\xbd\x02\n" +
+	"\x05Order\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tuser_role\x18\x02 \x01(\tR\buserRole\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"target_url\x18\x04 \x01(\tR\ttargetUrl\x12\x1f\n" +
+	"\vservice_url\x18\x05 \x01(\tR\n" +
+	"serviceUrl\x12\x1d\n" +
+	"\n" +
+	"order_type\x18\x06 \x01(\tR\torderType\x12\x1a\n" +
+	"\bquantity\x18\a \x01(\x05R\bquantity\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"2\n" +
+	"\rUserOrdersReq\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\"6\n" +
+	"\rUserOrdersRes\x12%\n" +
+	"\x06orders\x18\x01 \x03(\v2\r.orders.OrderR\x06orders\"7\n" +
+	"\x12EraseUserOrdersReq\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\",\n" +
+	"\x12EraseUserOrdersRes\x12\x16\n" +
+	"\x06erased\x18\x01 \x01(\x03R\x06erased\"Z\n" +
+	"\x0fUserDeletionReq\x12!\n" +
+	"\asaga_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06sagaId\x12$\n" +
+	"\auser_id\x18\x02 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\x06userId\"N\n" +
+	"\x0eUserDeletedRes\x12\x1c\n" +
+	"\tcancelled\x18\x01 \x01(\x03R\tcancelled\x12\x1e\n" +
+	"\n" +
+	"anonymised\x18\x02 \x01(\x03R\n" +
+	"anonymised\"\x11\n" +
+	"\x0fUserDeletionRes2\x8e\x04\n" +
 	"\fOrderService\x124\n" +
 	"\bAddOrder\x12\x13.orders.AddOrderReq\x1a\x13.orders.AddOrderRes\x127\n" +
 	"\tOrderInfo\x12\x14.orders.OrderInfoReq\x1a\x14.orders.OrderInfoRes\x124\n" +
-	"\bDelOrder\x12\x13.orders.DelOrderReq\x1a\x13.orders.DelOrderResB\x12Z\x10./;ordersserviceb\x06proto3"
+	"\bDelOrder\x12\x13.orders.DelOrderReq\x1a\x13.orders.DelOrderRes\x12:\n" +
+	"\n" +
+	"UserOrders\x12\x15.orders.UserOrdersReq\x1a\x15.orders.UserOrdersRes\x12I\n" +
+	"\x0fEraseUserOrders\x12\x1a.orders.EraseUserOrdersReq\x1a\x1a.orders.EraseUserOrdersRes\x12>\n" +
+	"\vUserDeleted\x12\x17.orders.UserDeletionReq\x1a\x16.orders.UserDeletedRes\x12F\n" +
+	"\x12FinishUserDeletion\x12\x17.orders.UserDeletionReq\x1a\x17.orders.UserDeletionRes\x12J\n" +
+	"\x16CompensateUserDeletion\x12\x17.orders.UserDeletionReq\x1a\x17.orders.UserDeletionResB\x12Z\x10./;ordersserviceb\x06proto3"
 
 var (
 	file_order_service_proto_rawDescOnce sync.Once
@@ -456,7 +945,7 @@ func file_order_service_proto_rawDescGZIP() []byte {
 	return file_order_service_proto_rawDescData
 }
 
-var file_order_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_order_service_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_order_service_proto_goTypes = []any{
 	(*AddOrderReq)(nil),           // 0: orders.AddOrderReq
 	(*AddOrderRes)(nil),           // 1: orders.AddOrderRes
@@ -464,22 +953,43 @@ var file_order_service_proto_goTypes = []any{
 	(*OrderInfoRes)(nil),          // 3: orders.OrderInfoRes
 	(*DelOrderReq)(nil),           // 4: orders.DelOrderReq
 	(*DelOrderRes)(nil),           // 5: orders.DelOrderRes
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*Order)(nil),                 // 6: orders.Order
+	(*UserOrdersReq)(nil),         // 7: orders.UserOrdersReq
+	(*UserOrdersRes)(nil),         // 8: orders.UserOrdersRes
+	(*EraseUserOrdersReq)(nil),    // 9: orders.EraseUserOrdersReq
+	(*EraseUserOrdersRes)(nil),    // 10: orders.EraseUserOrdersRes
+	(*UserDeletionReq)(nil),       // 11: orders.UserDeletionReq
+	(*UserDeletedRes)(nil),        // 12: orders.UserDeletedRes
+	(*UserDeletionRes)(nil),       // 13: orders.UserDeletionRes
+	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
 }
 var file_order_service_proto_depIdxs = []int32{
-	6, // 0: orders.OrderInfoRes.created_at:type_name -> google.protobuf.Timestamp
-	6, // 1: orders.OrderInfoRes.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 2: orders.OrderService.AddOrder:input_type -> orders.AddOrderReq
-	2, // 3: orders.OrderService.OrderInfo:input_type -> orders.OrderInfoReq
-	4, // 4: orders.OrderService.DelOrder:input_type -> orders.DelOrderReq
-	1, // 5: orders.OrderService.AddOrder:output_type -> orders.AddOrderRes
-	3, // 6: orders.OrderService.OrderInfo:output_type -> orders.OrderInfoRes
-	5, // 7: orders.OrderService.DelOrder:output_type -> orders.DelOrderRes
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	14, // 0: orders.OrderInfoRes.created_at:type_name -> google.protobuf.Timestamp
+	14, // 1: orders.OrderInfoRes.updated_at:type_name -> google.protobuf.Timestamp
+	14, // 2: orders.Order.created_at:type_name -> google.protobuf.Timestamp
+	14, // 3: orders.Order.updated_at:type_name -> google.protobuf.Timestamp
+	6,  // 4: orders.UserOrdersRes.orders:type_name -> orders.Order
+	0,  // 5: orders.OrderService.AddOrder:input_type -> orders.AddOrderReq
+	2,  // 6: orders.OrderService.OrderInfo:input_type -> orders.OrderInfoReq
+	4,  // 7: orders.OrderService.DelOrder:input_type -> orders.DelOrderReq
+	7,  // 8: orders.OrderService.UserOrders:input_type -> orders.UserOrdersReq
+	9,  // 9: orders.OrderService.EraseUserOrders:input_type -> orders.EraseUserOrdersReq
+	11, // 10: orders.OrderService.UserDeleted:input_type -> orders.UserDeletionReq
+	11, // 11: orders.OrderService.FinishUserDeletion:input_type -> orders.UserDeletionReq
+	11, // 12: orders.OrderService.CompensateUserDeletion:input_type -> orders.UserDeletionReq
+	1,  // 13: orders.OrderService.AddOrder:output_type -> orders.AddOrderRes
+	3,  // 14: orders.OrderService.OrderInfo:output_type -> orders.OrderInfoRes
+	5,  // 15: orders.OrderService.DelOrder:output_type -> orders.DelOrderRes
+	8,  // 16: orders.OrderService.UserOrders:output_type -> orders.UserOrdersRes
+	10, // 17: orders.OrderService.EraseUserOrders:output_type -> orders.EraseUserOrdersRes
+	12, // 18: orders.OrderService.UserDeleted:output_type -> orders.UserDeletedRes
+	13, // 19: orders.OrderService.FinishUserDeletion:output_type -> orders.UserDeletionRes
+	13, // 20: orders.OrderService.CompensateUserDeletion:output_type -> orders.UserDeletionRes
+	13, // [13:21] is the sub-list for method output_type
+	5,  // [5:13] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_order_service_proto_init() }
@@ -493,7 +1003,7 @@ func file_order_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_service_proto_rawDesc), len(file_order_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -72,10 +72,10 @@ func (m *AddOrderReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _AddOrderReq_UserRole_InLookup[m.GetUserRole()]; !ok {
+	if l := utf8.RuneCountInString(m.GetUserRole()); l < 1 || l > 50 {
 		err := AddOrderReqValidationError{
 			field:  "UserRole",
-			reason: "value must be in list [admin dev guest]",
+			reason: "value length must be between 1 and 50 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -232,12 +232,6 @@ var _ interface {
 	ErrorName() string
 } = AddOrderReqValidationError{}
 
-var _AddOrderReq_UserRole_InLookup = map[string]struct{}{
-	"admin": {},
-	"dev":   {},
-	"guest": {},
-}
-
 var _AddOrderReq_OrderType_InLookup = map[string]struct{}{
 	"comments": {},
 	"likes":    {},
@@ -528,10 +522,10 @@ func (m *OrderInfoRes) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _OrderInfoRes_UserRole_InLookup[m.GetUserRole()]; !ok {
+	if l := utf8.RuneCountInString(m.GetUserRole()); l < 1 || l > 50 {
 		err := OrderInfoResValidationError{
 			field:  "UserRole",
-			reason: "value must be in list [admin dev guest]",
+			reason: "value length must be between 1 and 50 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -710,12 +704,6 @@ var _ interface {
 	ErrorName() string
 } = OrderInfoResValidationError{}
 
-var _OrderInfoRes_UserRole_InLookup = map[string]struct{}{
-	"admin": {},
-	"dev":   {},
-	"guest": {},
-}
-
 var _OrderInfoRes_Status_InLookup = map[string]struct{}{
 	"done":       {},
 	"cancelled":  {},
@@ -774,10 +762,10 @@ func (m *DelOrderReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _DelOrderReq_Role_InLookup[m.GetRole()]; !ok {
+	if l := utf8.RuneCountInString(m.GetRole()); l < 1 || l > 50 {
 		err := DelOrderReqValidationError{
 			field:  "Role",
-			reason: "value must be in list [admin dev guest]",
+			reason: "value length must be between 1 and 50 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -870,12 +858,6 @@ var _ interface {
 	ErrorName() string
 } = DelOrderReqValidationError{}
 
-var _DelOrderReq_Role_InLookup = map[string]struct{}{
-	"admin": {},
-	"dev":   {},
-	"guest": {},
-}
-
 // Validate checks the field values on DelOrderRes with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -974,3 +956,993 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = DelOrderResValidationError{}
+
+// Validate checks the field values on Order with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Order) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Order with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in OrderMultiError, or nil if none found.
+func (m *Order) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Order) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserRole
+
+	// no validation rules for Status
+
+	// no validation rules for TargetUrl
+
+	// no validation rules for ServiceUrl
+
+	// no validation rules for OrderType
+
+	// no validation rules for Quantity
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrderMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderMultiError is an error wrapping multiple validation errors returned by
+// Order.ValidateAll() if the designated constraints aren't met.
+type OrderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderMultiError) AllErrors() []error { return m }
+
+// OrderValidationError is the validation error returned by Order.Validate if
+// the designated constraints aren't met.
+type OrderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderValidationError) ErrorName() string { return "OrderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrder.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderValidationError{}
+
+// Validate checks the field values on UserOrdersReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserOrdersReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserOrdersReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserOrdersReqMultiError, or
+// nil if none found.
+func (m *UserOrdersReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserOrdersReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = UserOrdersReqValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UserOrdersReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UserOrdersReq) _validateUuid(uuid string) error {
+	if matched := _order_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UserOrdersReqMultiError is an error wrapping multiple validation errors
+// returned by UserOrdersReq.ValidateAll() if the designated constraints
+// aren't met.
+type UserOrdersReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserOrdersReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserOrdersReqMultiError) AllErrors() []error { return m }
+
+// UserOrdersReqValidationError is the validation error returned by
+// UserOrdersReq.Validate if the designated constraints aren't met.
+type UserOrdersReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserOrdersReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserOrdersReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserOrdersReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserOrdersReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserOrdersReqValidationError) ErrorName() string { return "UserOrdersReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserOrdersReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserOrdersReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserOrdersReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserOrdersReqValidationError{}
+
+// Validate checks the field values on UserOrdersRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserOrdersRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserOrdersRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserOrdersResMultiError, or
+// nil if none found.
+func (m *UserOrdersRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserOrdersRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserOrdersResValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserOrdersResValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserOrdersResValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UserOrdersResMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserOrdersResMultiError is an error wrapping multiple validation errors
+// returned by UserOrdersRes.ValidateAll() if the designated constraints
+// aren't met.
+type UserOrdersResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserOrdersResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserOrdersResMultiError) AllErrors() []error { return m }
+
+// UserOrdersResValidationError is the validation error returned by
+// UserOrdersRes.Validate if the designated constraints aren't met.
+type UserOrdersResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserOrdersResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserOrdersResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserOrdersResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserOrdersResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserOrdersResValidationError) ErrorName() string { return "UserOrdersResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserOrdersResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserOrdersRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserOrdersResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserOrdersResValidationError{}
+
+// Validate checks the field values on EraseUserOrdersReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EraseUserOrdersReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EraseUserOrdersReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EraseUserOrdersReqMultiError, or nil if none found.
+func (m *EraseUserOrdersReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EraseUserOrdersReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = EraseUserOrdersReqValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return EraseUserOrdersReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *EraseUserOrdersReq) _validateUuid(uuid string) error {
+	if matched := _order_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// EraseUserOrdersReqMultiError is an error wrapping multiple validation errors
+// returned by EraseUserOrdersReq.ValidateAll() if the designated constraints
+// aren't met.
+type EraseUserOrdersReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EraseUserOrdersReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EraseUserOrdersReqMultiError) AllErrors() []error { return m }
+
+// EraseUserOrdersReqValidationError is the validation error returned by
+// EraseUserOrdersReq.Validate if the designated constraints aren't met.
+type EraseUserOrdersReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EraseUserOrdersReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EraseUserOrdersReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EraseUserOrdersReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EraseUserOrdersReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EraseUserOrdersReqValidationError) ErrorName() string {
+	return "EraseUserOrdersReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EraseUserOrdersReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEraseUserOrdersReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EraseUserOrdersReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EraseUserOrdersReqValidationError{}
+
+// Validate checks the field values on EraseUserOrdersRes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EraseUserOrdersRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EraseUserOrdersRes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EraseUserOrdersResMultiError, or nil if none found.
+func (m *EraseUserOrdersRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EraseUserOrdersRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Erased
+
+	if len(errors) > 0 {
+		return EraseUserOrdersResMultiError(errors)
+	}
+
+	return nil
+}
+
+// EraseUserOrdersResMultiError is an error wrapping multiple validation errors
+// returned by EraseUserOrdersRes.ValidateAll() if the designated constraints
+// aren't met.
+type EraseUserOrdersResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EraseUserOrdersResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EraseUserOrdersResMultiError) AllErrors() []error { return m }
+
+// EraseUserOrdersResValidationError is the validation error returned by
+// EraseUserOrdersRes.Validate if the designated constraints aren't met.
+type EraseUserOrdersResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EraseUserOrdersResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EraseUserOrdersResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EraseUserOrdersResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EraseUserOrdersResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EraseUserOrdersResValidationError) ErrorName() string {
+	return "EraseUserOrdersResValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EraseUserOrdersResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEraseUserOrdersRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EraseUserOrdersResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EraseUserOrdersResValidationError{}
+
+// Validate checks the field values on UserDeletionReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserDeletionReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserDeletionReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserDeletionReqMultiError, or nil if none found.
+func (m *UserDeletionReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserDeletionReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetSagaId()); err != nil {
+		err = UserDeletionReqValidationError{
+			field:  "SagaId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserId() != "" {
+
+		if err := m._validateUuid(m.GetUserId()); err != nil {
+			err = UserDeletionReqValidationError{
+				field:  "UserId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UserDeletionReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UserDeletionReq) _validateUuid(uuid string) error {
+	if matched := _order_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UserDeletionReqMultiError is an error wrapping multiple validation errors
+// returned by UserDeletionReq.ValidateAll() if the designated constraints
+// aren't met.
+type UserDeletionReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserDeletionReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserDeletionReqMultiError) AllErrors() []error { return m }
+
+// UserDeletionReqValidationError is the validation error returned by
+// UserDeletionReq.Validate if the designated constraints aren't met.
+type UserDeletionReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserDeletionReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserDeletionReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserDeletionReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserDeletionReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserDeletionReqValidationError) ErrorName() string { return "UserDeletionReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserDeletionReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserDeletionReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserDeletionReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserDeletionReqValidationError{}
+
+// Validate checks the field values on UserDeletedRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserDeletedRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserDeletedRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserDeletedResMultiError,
+// or nil if none found.
+func (m *UserDeletedRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserDeletedRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Cancelled
+
+	// no validation rules for Anonymised
+
+	if len(errors) > 0 {
+		return UserDeletedResMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserDeletedResMultiError is an error wrapping multiple validation errors
+// returned by UserDeletedRes.ValidateAll() if the designated constraints
+// aren't met.
+type UserDeletedResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserDeletedResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserDeletedResMultiError) AllErrors() []error { return m }
+
+// UserDeletedResValidationError is the validation error returned by
+// UserDeletedRes.Validate if the designated constraints aren't met.
+type UserDeletedResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserDeletedResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserDeletedResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserDeletedResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserDeletedResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserDeletedResValidationError) ErrorName() string { return "UserDeletedResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserDeletedResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserDeletedRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserDeletedResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserDeletedResValidationError{}
+
+// Validate checks the field values on UserDeletionRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserDeletionRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserDeletionRes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserDeletionResMultiError, or nil if none found.
+func (m *UserDeletionRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserDeletionRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UserDeletionResMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserDeletionResMultiError is an error wrapping multiple validation errors
+// returned by UserDeletionRes.ValidateAll() if the designated constraints
+// aren't met.
+type UserDeletionResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserDeletionResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserDeletionResMultiError) AllErrors() []error { return m }
+
+// UserDeletionResValidationError is the validation error returned by
+// UserDeletionRes.Validate if the designated constraints aren't met.
+type UserDeletionResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserDeletionResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserDeletionResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserDeletionResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserDeletionResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserDeletionResValidationError) ErrorName() string { return "UserDeletionResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserDeletionResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserDeletionRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserDeletionResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserDeletionResValidationError{}
@@ -19,9 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrderService_AddOrder_FullMethodName  = "/orders.OrderService/AddOrder"
-	OrderService_OrderInfo_FullMethodName = "/orders.OrderService/OrderInfo"
-	OrderService_DelOrder_FullMethodName  = "/orders.OrderService/DelOrder"
+	OrderService_AddOrder_FullMethodName               = "/orders.OrderService/AddOrder"
+	OrderService_OrderInfo_FullMethodName              = "/orders.OrderService/OrderInfo"
+	OrderService_DelOrder_FullMethodName               = "/orders.OrderService/DelOrder"
+	OrderService_UserOrders_FullMethodName             = "/orders.OrderService/UserOrders"
+	OrderService_EraseUserOrders_FullMethodName        = "/orders.OrderService/EraseUserOrders"
+	OrderService_UserDeleted_FullMethodName            = "/orders.OrderService/UserDeleted"
+	OrderService_FinishUserDeletion_FullMethodName     = "/orders.OrderService/FinishUserDeletion"
+	OrderService_CompensateUserDeletion_FullMethodName = "/orders.OrderService/CompensateUserDeletion"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -31,6 +36,13 @@ type OrderServiceClient interface {
 	AddOrder(ctx context.Context, in *AddOrderReq, opts ...grpc.CallOption) (*AddOrderRes, error)
 	OrderInfo(ctx context.Context, in *OrderInfoReq, opts ...grpc.CallOption) (*OrderInfoRes, error)
 	DelOrder(ctx context.Context, in *DelOrderReq, opts ...grpc.CallOption) (*DelOrderRes, error)
+	// called by user-service for data export and erasure
+	UserOrders(ctx context.Context, in *UserOrdersReq, opts ...grpc.CallOption) (*UserOrdersRes, error)
+	EraseUserOrders(ctx context.Context, in *EraseUserOrdersReq, opts ...grpc.CallOption) (*EraseUserOrdersRes, error)
+	// steps of the user deletion saga, each idempotent per saga_id
+	UserDeleted(ctx context.Context, in *UserDeletionReq, opts ...grpc.CallOption) (*UserDeletedRes, error)
+	FinishUserDeletion(ctx context.Context, in *UserDeletionReq, opts ...grpc.CallOption) (*UserDeletionRes, error)
+	CompensateUserDeletion(ctx context.Context, in *UserDeletionReq, opts ...grpc.CallOption) (*UserDeletionRes, error)
 }
 
 type orderServiceClient struct {
@@ -71,6 +83,56 @@ func (c *orderServiceClient) DelOrder(ctx context.Context, in *DelOrderReq, opts
 	return out, nil
 }
 
+func (c *orderServiceClient) UserOrders(ctx context.Context, in *UserOrdersReq, opts ...grpc.CallOption) (*UserOrdersRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserOrdersRes)
+	err := c.cc.Invoke(ctx, OrderService_UserOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) EraseUserOrders(ctx context.Context, in *EraseUserOrdersReq, opts ...grpc.CallOption) (*EraseUserOrdersRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EraseUserOrdersRes)
+	err := c.cc.Invoke(ctx, OrderService_EraseUserOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) UserDeleted(ctx context.Context, in *UserDeletionReq, opts ...grpc.CallOption) (*UserDeletedRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDeletedRes)
+	err := c.cc.Invoke(ctx, OrderService_UserDeleted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) FinishUserDeletion(ctx context.Context, in *UserDeletionReq, opts ...grpc.CallOption) (*UserDeletionRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDeletionRes)
+	err := c.cc.Invoke(ctx, OrderService_FinishUserDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CompensateUserDeletion(ctx context.Context, in *UserDeletionReq, opts ...grpc.CallOption) (*UserDeletionRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDeletionRes)
+	err := c.cc.Invoke(ctx, OrderService_CompensateUserDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility.
@@ -78,6 +140,13 @@ type OrderServiceServer interface {
 	AddOrder(context.Context, *AddOrderReq) (*AddOrderRes, error)
 	OrderInfo(context.Context, *OrderInfoReq) (*OrderInfoRes, error)
 	DelOrder(context.Context, *DelOrderReq) (*DelOrderRes, error)
+	// called by user-service for data export and erasure
+	UserOrders(context.Context, *UserOrdersReq) (*UserOrdersRes, error)
+	EraseUserOrders(context.Context, *EraseUserOrdersReq) (*EraseUserOrdersRes, error)
+	// steps of the user deletion saga, each idempotent per saga_id
+	UserDeleted(context.Context, *UserDeletionReq) (*UserDeletedRes, error)
+	FinishUserDeletion(context.Context, *UserDeletionReq) (*UserDeletionRes, error)
+	CompensateUserDeletion(context.Context, *UserDeletionReq) (*UserDeletionRes, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -97,6 +166,21 @@ func (UnimplementedOrderServiceServer) OrderInfo(context.Context, *OrderInfoReq)
 func (UnimplementedOrderServiceServer) DelOrder(context.Context, *DelOrderReq) (*DelOrderRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelOrder not implemented")
 }
+func (UnimplementedOrderServiceServer) UserOrders(context.Context, *UserOrdersReq) (*UserOrdersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) EraseUserOrders(context.Context, *EraseUserOrdersReq) (*EraseUserOrdersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseUserOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) UserDeleted(context.Context, *UserDeletionReq) (*UserDeletedRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserDeleted not implemented")
+}
+func (UnimplementedOrderServiceServer) FinishUserDeletion(context.Context, *UserDeletionReq) (*UserDeletionRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishUserDeletion not implemented")
+}
+func (UnimplementedOrderServiceServer) CompensateUserDeletion(context.Context, *UserDeletionReq) (*UserDeletionRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompensateUserDeletion not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 func (UnimplementedOrderServiceServer) testEmbeddedByValue()                      {}
 
@@ -172,6 +256,96 @@ func _OrderService_DelOrder_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_UserOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserOrdersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).UserOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_UserOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).UserOrders(ctx, req.(*UserOrdersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_EraseUserOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseUserOrdersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).EraseUserOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_EraseUserOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).EraseUserOrders(ctx, req.(*EraseUserOrdersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_UserDeleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserDeletionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).UserDeleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_UserDeleted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).UserDeleted(ctx, req.(*UserDeletionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_FinishUserDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserDeletionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).FinishUserDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_FinishUserDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).FinishUserDeletion(ctx, req.(*UserDeletionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CompensateUserDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserDeletionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CompensateUserDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CompensateUserDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CompensateUserDeletion(ctx, req.(*UserDeletionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +365,26 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelOrder",
 			Handler:    _OrderService_DelOrder_Handler,
 		},
+		{
+			MethodName: "UserOrders",
+			Handler:    _OrderService_UserOrders_Handler,
+		},
+		{
+			MethodName: "EraseUserOrders",
+			Handler:    _OrderService_EraseUserOrders_Handler,
+		},
+		{
+			MethodName: "UserDeleted",
+			Handler:    _OrderService_UserDeleted_Handler,
+		},
+		{
+			MethodName: "FinishUserDeletion",
+			Handler:    _OrderService_FinishUserDeletion_Handler,
+		},
+		{
+			MethodName: "CompensateUserDeletion",
+			Handler:    _OrderService_CompensateUserDeletion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "order-service.proto",
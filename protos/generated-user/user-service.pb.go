@@ -10,6 +10,7 @@ import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,11 +24,16 @@ const (
 )
 
 type RegReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
-	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// Ignored: new users always get the default role.
+	//
+	// Deprecated: Marked as deprecated in user-service.proto.
+	Role     string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// Required when user-service runs with INVITE_ONLY.
+	InviteCode    string `protobuf:"bytes,5,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -76,6 +82,7 @@ func (x *RegReq) GetEmail() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in user-service.proto.
 func (x *RegReq) GetRole() string {
 	if x != nil {
 		return x.Role
@@ -90,6 +97,13 @@ func (x *RegReq) GetPassword() string {
 	return ""
 }
 
+func (x *RegReq) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
 type RegRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
@@ -147,6 +161,7 @@ type LogReq struct {
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
 	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,4,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -202,12 +217,22 @@ func (x *LogReq) GetPassword() string {
 	return ""
 }
 
+func (x *LogReq) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
 type LogRes struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	SessionKey    string                 `protobuf:"bytes,2,opt,name=session_key,json=sessionKey,proto3" json:"session_key,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Token            string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	SessionKey       string                 `protobuf:"bytes,2,opt,name=session_key,json=sessionKey,proto3" json:"session_key,omitempty"`
+	MfaRequired      bool                   `protobuf:"varint,3,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	MfaToken         string                 `protobuf:"bytes,4,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	MfaEnrolRequired bool                   `protobuf:"varint,5,opt,name=mfa_enrol_required,json=mfaEnrolRequired,proto3" json:"mfa_enrol_required,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *LogRes) Reset() {
@@ -254,6 +279,131 @@ func (x *LogRes) GetSessionKey() string {
 	return ""
 }
 
+func (x *LogRes) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *LogRes) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *LogRes) GetMfaEnrolRequired() bool {
+	if x != nil {
+		return x.MfaEnrolRequired
+	}
+	return false
+}
+
+type VerifyMFAReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MfaToken      string                 `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyMFAReq) Reset() {
+	*x = VerifyMFAReq{}
+	mi := &file_user_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMFAReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMFAReq) ProtoMessage() {}
+
+func (x *VerifyMFAReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMFAReq.ProtoReflect.Descriptor instead.
+func (*VerifyMFAReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *VerifyMFAReq) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *VerifyMFAReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type VerifyMFARes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	SessionKey    string                 `protobuf:"bytes,2,opt,name=session_key,json=sessionKey,proto3" json:"session_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyMFARes) Reset() {
+	*x = VerifyMFARes{}
+	mi := &file_user_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMFARes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMFARes) ProtoMessage() {}
+
+func (x *VerifyMFARes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMFARes.ProtoReflect.Descriptor instead.
+func (*VerifyMFARes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VerifyMFARes) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *VerifyMFARes) GetSessionKey() string {
+	if x != nil {
+		return x.SessionKey
+	}
+	return ""
+}
+
 type ExtJWTDataReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
@@ -264,7 +414,7 @@ type ExtJWTDataReq struct {
 
 func (x *ExtJWTDataReq) Reset() {
 	*x = ExtJWTDataReq{}
-	mi := &file_user_service_proto_msgTypes[4]
+	mi := &file_user_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -276,7 +426,7 @@ func (x *ExtJWTDataReq) String() string {
 func (*ExtJWTDataReq) ProtoMessage() {}
 
 func (x *ExtJWTDataReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[4]
+	mi := &file_user_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -289,7 +439,7 @@ func (x *ExtJWTDataReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtJWTDataReq.ProtoReflect.Descriptor instead.
 func (*ExtJWTDataReq) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{4}
+	return file_user_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ExtJWTDataReq) GetToken() string {
@@ -311,13 +461,16 @@ type ExtJWTDataRes struct {
 	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	EmailVerified bool                   `protobuf:"varint,4,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	Permissions   []string               `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	ActorId       string                 `protobuf:"bytes,6,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ExtJWTDataRes) Reset() {
 	*x = ExtJWTDataRes{}
-	mi := &file_user_service_proto_msgTypes[5]
+	mi := &file_user_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -329,7 +482,7 @@ func (x *ExtJWTDataRes) String() string {
 func (*ExtJWTDataRes) ProtoMessage() {}
 
 func (x *ExtJWTDataRes) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[5]
+	mi := &file_user_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -342,7 +495,7 @@ func (x *ExtJWTDataRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtJWTDataRes.ProtoReflect.Descriptor instead.
 func (*ExtJWTDataRes) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{5}
+	return file_user_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ExtJWTDataRes) GetRole() string {
@@ -366,19 +519,41 @@ func (x *ExtJWTDataRes) GetToken() string {
 	return ""
 }
 
+func (x *ExtJWTDataRes) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *ExtJWTDataRes) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *ExtJWTDataRes) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
 type DelUserReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	DelUserId     string                 `protobuf:"bytes,3,opt,name=del_user_id,json=delUserId,proto3" json:"del_user_id,omitempty"`
 	SessionKey    string                 `protobuf:"bytes,4,opt,name=session_key,json=sessionKey,proto3" json:"session_key,omitempty"`
+	Permissions   []string               `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DelUserReq) Reset() {
 	*x = DelUserReq{}
-	mi := &file_user_service_proto_msgTypes[6]
+	mi := &file_user_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -390,7 +565,7 @@ func (x *DelUserReq) String() string {
 func (*DelUserReq) ProtoMessage() {}
 
 func (x *DelUserReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[6]
+	mi := &file_user_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -403,7 +578,7 @@ func (x *DelUserReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DelUserReq.ProtoReflect.Descriptor instead.
 func (*DelUserReq) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{6}
+	return file_user_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DelUserReq) GetRole() string {
@@ -434,15 +609,23 @@ func (x *DelUserReq) GetSessionKey() string {
 	return ""
 }
 
+func (x *DelUserReq) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
 type DelUserRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeletionId    string                 `protobuf:"bytes,1,opt,name=deletion_id,json=deletionId,proto3" json:"deletion_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DelUserRes) Reset() {
 	*x = DelUserRes{}
-	mi := &file_user_service_proto_msgTypes[7]
+	mi := &file_user_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -454,7 +637,7 @@ func (x *DelUserRes) String() string {
 func (*DelUserRes) ProtoMessage() {}
 
 func (x *DelUserRes) ProtoReflect() protoreflect.Message {
-	mi := &file_user_service_proto_msgTypes[7]
+	mi := &file_user_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -467,54 +650,5169 @@ func (x *DelUserRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DelUserRes.ProtoReflect.Descriptor instead.
 func (*DelUserRes) Descriptor() ([]byte, []int) {
-	return file_user_service_proto_rawDescGZIP(), []int{7}
+	return file_user_service_proto_rawDescGZIP(), []int{9}
 }
 
-var File_user_service_proto protoreflect.FileDescriptor
+func (x *DelUserRes) GetDeletionId() string {
+	if x != nil {
+		return x.DeletionId
+	}
+	return ""
+}
 
-const file_user_service_proto_rawDesc = "" +
-	"\n" +
-	"\x12user-service.proto\x12\x05users\x1a\x17validate/validate.proto\"\x99\x01\n" +
-	"\x06RegReq\x12\x1d\n" +
-	"\x04name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x02\x182R\x04name\x12\x1d\n" +
-	"\x05email\x18\x02 \x01(\tB\a\xfaB\x04r\x02`\x01R\x05email\x12,\n" +
-	"\x04role\x18\x03 \x01(\tB\x18\xfaB\x15r\x13R\x05adminR\x03devR\x05guestR\x04role\x12#\n" +
-	"\bpassword\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\bR\bpassword\"R\n" +
-	"\x06RegRes\x12\x1d\n" +
-	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10dR\x05token\x12)\n" +
-	"\vsession_key\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
-	"sessionKey\"k\n" +
-	"\x06LogReq\x12\x1d\n" +
-	"\x04name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x02\x182R\x04name\x12\x1d\n" +
-	"\x05email\x18\x02 \x01(\tB\a\xfaB\x04r\x02`\x01R\x05email\x12#\n" +
-	"\bpassword\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\bR\bpassword\"R\n" +
-	"\x06LogRes\x12\x1d\n" +
-	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10dR\x05token\x12)\n" +
-	"\vsession_key\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
-	"sessionKey\"Y\n" +
-	"\rExtJWTDataReq\x12\x1d\n" +
-	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10dR\x05token\x12)\n" +
-	"\vsession_key\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
-	"sessionKey\"u\n" +
-	"\rExtJWTDataRes\x12,\n" +
-	"\x04role\x18\x01 \x01(\tB\x18\xfaB\x15r\x13R\x05adminR\x03devR\x05guestR\x04role\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
-	"\x05token\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10dR\x05token\"\xa8\x01\n" +
-	"\n" +
-	"DelUserReq\x12,\n" +
-	"\x04role\x18\x01 \x01(\tB\x18\xfaB\x15r\x13R\x05adminR\x03devR\x05guestR\x04role\x12!\n" +
-	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12\x1e\n" +
-	"\vdel_user_id\x18\x03 \x01(\tR\tdelUserId\x12)\n" +
-	"\vsession_key\x18\x04 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
-	"sessionKey\"\f\n" +
-	"\n" +
-	"DelUserRes2\xca\x01\n" +
-	"\vUserService\x12'\n" +
-	"\aRegUser\x12\r.users.RegReq\x1a\r.users.RegRes\x12'\n" +
-	"\aLogUser\x12\r.users.LogReq\x1a\r.users.LogRes\x128\n" +
-	"\n" +
-	"ExtJWTData\x12\x14.users.ExtJWTDataReq\x1a\x14.users.ExtJWTDataRes\x12/\n" +
-	"\aDelUser\x12\x11.users.DelUserReq\x1a\x11.users.DelUserResB\x10Z\x0e./;userserviceb\x06proto3"
+type VerifyEmailReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailReq) Reset() {
+	*x = VerifyEmailReq{}
+	mi := &file_user_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailReq) ProtoMessage() {}
+
+func (x *VerifyEmailReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailReq.ProtoReflect.Descriptor instead.
+func (*VerifyEmailReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *VerifyEmailReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type VerifyEmailRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailRes) Reset() {
+	*x = VerifyEmailRes{}
+	mi := &file_user_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailRes) ProtoMessage() {}
+
+func (x *VerifyEmailRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailRes.ProtoReflect.Descriptor instead.
+func (*VerifyEmailRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{11}
+}
+
+type ResendVerificationReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendVerificationReq) Reset() {
+	*x = ResendVerificationReq{}
+	mi := &file_user_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendVerificationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendVerificationReq) ProtoMessage() {}
+
+func (x *ResendVerificationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendVerificationReq.ProtoReflect.Descriptor instead.
+func (*ResendVerificationReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ResendVerificationReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ResendVerificationRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendVerificationRes) Reset() {
+	*x = ResendVerificationRes{}
+	mi := &file_user_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendVerificationRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendVerificationRes) ProtoMessage() {}
+
+func (x *ResendVerificationRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendVerificationRes.ProtoReflect.Descriptor instead.
+func (*ResendVerificationRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{13}
+}
+
+type EnrollTOTPReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MfaToken      string                 `protobuf:"bytes,2,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTOTPReq) Reset() {
+	*x = EnrollTOTPReq{}
+	mi := &file_user_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTOTPReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPReq) ProtoMessage() {}
+
+func (x *EnrollTOTPReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTOTPReq.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EnrollTOTPReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EnrollTOTPReq) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+type EnrollTOTPRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	OtpauthUri    string                 `protobuf:"bytes,2,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTOTPRes) Reset() {
+	*x = EnrollTOTPRes{}
+	mi := &file_user_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTOTPRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPRes) ProtoMessage() {}
+
+func (x *EnrollTOTPRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTOTPRes.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *EnrollTOTPRes) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollTOTPRes) GetOtpauthUri() string {
+	if x != nil {
+		return x.OtpauthUri
+	}
+	return ""
+}
+
+type ConfirmTOTPReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MfaToken      string                 `protobuf:"bytes,2,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPReq) Reset() {
+	*x = ConfirmTOTPReq{}
+	mi := &file_user_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPReq) ProtoMessage() {}
+
+func (x *ConfirmTOTPReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPReq.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ConfirmTOTPReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ConfirmTOTPReq) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *ConfirmTOTPReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmTOTPRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	SessionKey    string                 `protobuf:"bytes,3,opt,name=session_key,json=sessionKey,proto3" json:"session_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPRes) Reset() {
+	*x = ConfirmTOTPRes{}
+	mi := &file_user_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPRes) ProtoMessage() {}
+
+func (x *ConfirmTOTPRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPRes.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ConfirmTOTPRes) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+func (x *ConfirmTOTPRes) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ConfirmTOTPRes) GetSessionKey() string {
+	if x != nil {
+		return x.SessionKey
+	}
+	return ""
+}
+
+type SetMFARoleReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	TargetRole    string                 `protobuf:"bytes,2,opt,name=target_role,json=targetRole,proto3" json:"target_role,omitempty"`
+	Required      bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	Permissions   []string               `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMFARoleReq) Reset() {
+	*x = SetMFARoleReq{}
+	mi := &file_user_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMFARoleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMFARoleReq) ProtoMessage() {}
+
+func (x *SetMFARoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMFARoleReq.ProtoReflect.Descriptor instead.
+func (*SetMFARoleReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetMFARoleReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SetMFARoleReq) GetTargetRole() string {
+	if x != nil {
+		return x.TargetRole
+	}
+	return ""
+}
+
+func (x *SetMFARoleReq) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *SetMFARoleReq) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type SetMFARoleRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMFARoleRes) Reset() {
+	*x = SetMFARoleRes{}
+	mi := &file_user_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMFARoleRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMFARoleRes) ProtoMessage() {}
+
+func (x *SetMFARoleRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMFARoleRes.ProtoReflect.Descriptor instead.
+func (*SetMFARoleRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{19}
+}
+
+type UnlockUserReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockUserReq) Reset() {
+	*x = UnlockUserReq{}
+	mi := &file_user_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUserReq) ProtoMessage() {}
+
+func (x *UnlockUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockUserReq.ProtoReflect.Descriptor instead.
+func (*UnlockUserReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UnlockUserReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *UnlockUserReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnlockUserReq) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type UnlockUserRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockUserRes) Reset() {
+	*x = UnlockUserRes{}
+	mi := &file_user_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockUserRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUserRes) ProtoMessage() {}
+
+func (x *UnlockUserRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockUserRes.ProtoReflect.Descriptor instead.
+func (*UnlockUserRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{21}
+}
+
+type UserProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	EmailVerified bool                   `protobuf:"varint,5,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	TotpEnabled   bool                   `protobuf:"varint,6,opt,name=totp_enabled,json=totpEnabled,proto3" json:"totp_enabled,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,7,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Bio           string                 `protobuf:"bytes,8,opt,name=bio,proto3" json:"bio,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserProfile) Reset() {
+	*x = UserProfile{}
+	mi := &file_user_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfile) ProtoMessage() {}
+
+func (x *UserProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
+func (*UserProfile) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UserProfile) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UserProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserProfile) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserProfile) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *UserProfile) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *UserProfile) GetTotpEnabled() bool {
+	if x != nil {
+		return x.TotpEnabled
+	}
+	return false
+}
+
+func (x *UserProfile) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UserProfile) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UserProfile) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UserProfile) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetUserReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetId      string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Permissions   []string               `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReq) Reset() {
+	*x = GetUserReq{}
+	mi := &file_user_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReq) ProtoMessage() {}
+
+func (x *GetUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReq.ProtoReflect.Descriptor instead.
+func (*GetUserReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetUserReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *GetUserReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserReq) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *GetUserReq) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type GetUserRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *UserProfile           `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRes) Reset() {
+	*x = GetUserRes{}
+	mi := &file_user_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRes) ProtoMessage() {}
+
+func (x *GetUserRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRes.ProtoReflect.Descriptor instead.
+func (*GetUserRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetUserRes) GetUser() *UserProfile {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type UpdateUserReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Email         *string                `protobuf:"bytes,3,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	DisplayName   *string                `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name,omitempty"`
+	Bio           *string                `protobuf:"bytes,5,opt,name=bio,proto3,oneof" json:"bio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserReq) Reset() {
+	*x = UpdateUserReq{}
+	mi := &file_user_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserReq) ProtoMessage() {}
+
+func (x *UpdateUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserReq.ProtoReflect.Descriptor instead.
+func (*UpdateUserReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateUserReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateUserReq) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateUserReq) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *UpdateUserReq) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateUserReq) GetBio() string {
+	if x != nil && x.Bio != nil {
+		return *x.Bio
+	}
+	return ""
+}
+
+type UpdateUserRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *UserProfile           `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRes) Reset() {
+	*x = UpdateUserRes{}
+	mi := &file_user_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRes) ProtoMessage() {}
+
+func (x *UpdateUserRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRes.ProtoReflect.Descriptor instead.
+func (*UpdateUserRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateUserRes) GetUser() *UserProfile {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ListUsersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	RoleFilter    string                 `protobuf:"bytes,3,opt,name=role_filter,json=roleFilter,proto3" json:"role_filter,omitempty"`
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	Cursor        string                 `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         uint32                 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	Permissions   []string               `protobuf:"bytes,8,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersReq) Reset() {
+	*x = ListUsersReq{}
+	mi := &file_user_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersReq) ProtoMessage() {}
+
+func (x *ListUsersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersReq.ProtoReflect.Descriptor instead.
+func (*ListUsersReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListUsersReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ListUsersReq) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListUsersReq) GetRoleFilter() string {
+	if x != nil {
+		return x.RoleFilter
+	}
+	return ""
+}
+
+func (x *ListUsersReq) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ListUsersReq) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *ListUsersReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListUsersReq) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListUsersReq) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type ListUsersRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserProfile         `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersRes) Reset() {
+	*x = ListUsersRes{}
+	mi := &file_user_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRes) ProtoMessage() {}
+
+func (x *ListUsersRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRes.ProtoReflect.Descriptor instead.
+func (*ListUsersRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListUsersRes) GetUsers() []*UserProfile {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersRes) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type SetUserRoleReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetId      string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	NewRole       string                 `protobuf:"bytes,4,opt,name=new_role,json=newRole,proto3" json:"new_role,omitempty"`
+	Permissions   []string               `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserRoleReq) Reset() {
+	*x = SetUserRoleReq{}
+	mi := &file_user_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserRoleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserRoleReq) ProtoMessage() {}
+
+func (x *SetUserRoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserRoleReq.ProtoReflect.Descriptor instead.
+func (*SetUserRoleReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetUserRoleReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SetUserRoleReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserRoleReq) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *SetUserRoleReq) GetNewRole() string {
+	if x != nil {
+		return x.NewRole
+	}
+	return ""
+}
+
+func (x *SetUserRoleReq) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type SetUserRoleRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserRoleRes) Reset() {
+	*x = SetUserRoleRes{}
+	mi := &file_user_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserRoleRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserRoleRes) ProtoMessage() {}
+
+func (x *SetUserRoleRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserRoleRes.ProtoReflect.Descriptor instead.
+func (*SetUserRoleRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{30}
+}
+
+type ImpersonateReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetId      string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Permissions   []string               `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonateReq) Reset() {
+	*x = ImpersonateReq{}
+	mi := &file_user_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonateReq) ProtoMessage() {}
+
+func (x *ImpersonateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonateReq.ProtoReflect.Descriptor instead.
+func (*ImpersonateReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ImpersonateReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ImpersonateReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ImpersonateReq) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ImpersonateReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ImpersonateReq) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type ImpersonateRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	SessionKey    string                 `protobuf:"bytes,2,opt,name=session_key,json=sessionKey,proto3" json:"session_key,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonateRes) Reset() {
+	*x = ImpersonateRes{}
+	mi := &file_user_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonateRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonateRes) ProtoMessage() {}
+
+func (x *ImpersonateRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonateRes.ProtoReflect.Descriptor instead.
+func (*ImpersonateRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ImpersonateRes) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ImpersonateRes) GetSessionKey() string {
+	if x != nil {
+		return x.SessionKey
+	}
+	return ""
+}
+
+func (x *ImpersonateRes) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+type Deletion struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RequestedBy      string                 `protobuf:"bytes,3,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	State            string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Attempts         int32                  `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError        string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	OrdersCancelled  int64                  `protobuf:"varint,7,opt,name=orders_cancelled,json=ordersCancelled,proto3" json:"orders_cancelled,omitempty"`
+	OrdersAnonymised int64                  `protobuf:"varint,8,opt,name=orders_anonymised,json=ordersAnonymised,proto3" json:"orders_anonymised,omitempty"`
+	NextAttemptAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Deletion) Reset() {
+	*x = Deletion{}
+	mi := &file_user_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Deletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deletion) ProtoMessage() {}
+
+func (x *Deletion) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deletion.ProtoReflect.Descriptor instead.
+func (*Deletion) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Deletion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Deletion) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Deletion) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *Deletion) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Deletion) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Deletion) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Deletion) GetOrdersCancelled() int64 {
+	if x != nil {
+		return x.OrdersCancelled
+	}
+	return 0
+}
+
+func (x *Deletion) GetOrdersAnonymised() int64 {
+	if x != nil {
+		return x.OrdersAnonymised
+	}
+	return 0
+}
+
+func (x *Deletion) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *Deletion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Deletion) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListDeletionsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	All           bool                   `protobuf:"varint,3,opt,name=all,proto3" json:"all,omitempty"`
+	Limit         uint32                 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Permissions   []string               `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletionsReq) Reset() {
+	*x = ListDeletionsReq{}
+	mi := &file_user_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletionsReq) ProtoMessage() {}
+
+func (x *ListDeletionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletionsReq.ProtoReflect.Descriptor instead.
+func (*ListDeletionsReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListDeletionsReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ListDeletionsReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListDeletionsReq) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *ListDeletionsReq) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDeletionsReq) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type ListDeletionsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deletions     []*Deletion            `protobuf:"bytes,1,rep,name=deletions,proto3" json:"deletions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletionsRes) Reset() {
+	*x = ListDeletionsRes{}
+	mi := &file_user_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletionsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletionsRes) ProtoMessage() {}
+
+func (x *ListDeletionsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletionsRes.ProtoReflect.Descriptor instead.
+func (*ListDeletionsRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListDeletionsRes) GetDeletions() []*Deletion {
+	if x != nil {
+		return x.Deletions
+	}
+	return nil
+}
+
+type Invite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	MaxUses       int32                  `protobuf:"varint,4,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	Uses          int32                  `protobuf:"varint,5,opt,name=uses,proto3" json:"uses,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invite) Reset() {
+	*x = Invite{}
+	mi := &file_user_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invite) ProtoMessage() {}
+
+func (x *Invite) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invite.ProtoReflect.Descriptor instead.
+func (*Invite) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Invite) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invite) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Invite) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Invite) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *Invite) GetUses() int32 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *Invite) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Invite) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Invite) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Invite) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+type CreateInviteReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	InviteRole    string                 `protobuf:"bytes,3,opt,name=invite_role,json=inviteRole,proto3" json:"invite_role,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	MaxUses       int32                  `protobuf:"varint,5,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	TtlHours      uint32                 `protobuf:"varint,6,opt,name=ttl_hours,json=ttlHours,proto3" json:"ttl_hours,omitempty"`
+	Permissions   []string               `protobuf:"bytes,7,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteReq) Reset() {
+	*x = CreateInviteReq{}
+	mi := &file_user_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteReq) ProtoMessage() {}
+
+func (x *CreateInviteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteReq.ProtoReflect.Descriptor instead.
+func (*CreateInviteReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateInviteReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CreateInviteReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateInviteReq) GetInviteRole() string {
+	if x != nil {
+		return x.InviteRole
+	}
+	return ""
+}
+
+func (x *CreateInviteReq) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CreateInviteReq) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateInviteReq) GetTtlHours() uint32 {
+	if x != nil {
+		return x.TtlHours
+	}
+	return 0
+}
+
+func (x *CreateInviteReq) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type CreateInviteRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteRes) Reset() {
+	*x = CreateInviteRes{}
+	mi := &file_user_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteRes) ProtoMessage() {}
+
+func (x *CreateInviteRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteRes.ProtoReflect.Descriptor instead.
+func (*CreateInviteRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateInviteRes) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+func (x *CreateInviteRes) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ListInvitesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	All           bool                   `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitesReq) Reset() {
+	*x = ListInvitesReq{}
+	mi := &file_user_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesReq) ProtoMessage() {}
+
+func (x *ListInvitesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitesReq.ProtoReflect.Descriptor instead.
+func (*ListInvitesReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListInvitesReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ListInvitesReq) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *ListInvitesReq) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type ListInvitesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*Invite              `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitesRes) Reset() {
+	*x = ListInvitesRes{}
+	mi := &file_user_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesRes) ProtoMessage() {}
+
+func (x *ListInvitesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitesRes.ProtoReflect.Descriptor instead.
+func (*ListInvitesRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListInvitesRes) GetInvites() []*Invite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+type Redemption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RedeemedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=redeemed_at,json=redeemedAt,proto3" json:"redeemed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Redemption) Reset() {
+	*x = Redemption{}
+	mi := &file_user_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Redemption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Redemption) ProtoMessage() {}
+
+func (x *Redemption) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Redemption.ProtoReflect.Descriptor instead.
+func (*Redemption) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Redemption) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Redemption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Redemption) GetRedeemedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RedeemedAt
+	}
+	return nil
+}
+
+type ListRedemptionsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	InviteId      string                 `protobuf:"bytes,2,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRedemptionsReq) Reset() {
+	*x = ListRedemptionsReq{}
+	mi := &file_user_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRedemptionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRedemptionsReq) ProtoMessage() {}
+
+func (x *ListRedemptionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRedemptionsReq.ProtoReflect.Descriptor instead.
+func (*ListRedemptionsReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListRedemptionsReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ListRedemptionsReq) GetInviteId() string {
+	if x != nil {
+		return x.InviteId
+	}
+	return ""
+}
+
+func (x *ListRedemptionsReq) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type ListRedemptionsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Redemptions   []*Redemption          `protobuf:"bytes,1,rep,name=redemptions,proto3" json:"redemptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRedemptionsRes) Reset() {
+	*x = ListRedemptionsRes{}
+	mi := &file_user_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRedemptionsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRedemptionsRes) ProtoMessage() {}
+
+func (x *ListRedemptionsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRedemptionsRes.ProtoReflect.Descriptor instead.
+func (*ListRedemptionsRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListRedemptionsRes) GetRedemptions() []*Redemption {
+	if x != nil {
+		return x.Redemptions
+	}
+	return nil
+}
+
+type RevokeInviteReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	InviteId      string                 `protobuf:"bytes,3,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	Permissions   []string               `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteReq) Reset() {
+	*x = RevokeInviteReq{}
+	mi := &file_user_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteReq) ProtoMessage() {}
+
+func (x *RevokeInviteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteReq.ProtoReflect.Descriptor instead.
+func (*RevokeInviteReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RevokeInviteReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RevokeInviteReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RevokeInviteReq) GetInviteId() string {
+	if x != nil {
+		return x.InviteId
+	}
+	return ""
+}
+
+func (x *RevokeInviteReq) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type RevokeInviteRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteRes) Reset() {
+	*x = RevokeInviteRes{}
+	mi := &file_user_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteRes) ProtoMessage() {}
+
+func (x *RevokeInviteRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteRes.ProtoReflect.Descriptor instead.
+func (*RevokeInviteRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{45}
+}
+
+type ExportUserDataReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetId      string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataReq) Reset() {
+	*x = ExportUserDataReq{}
+	mi := &file_user_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataReq) ProtoMessage() {}
+
+func (x *ExportUserDataReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataReq.ProtoReflect.Descriptor instead.
+func (*ExportUserDataReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ExportUserDataReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ExportUserDataReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExportUserDataReq) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+type ExportUserDataRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Archive       []byte                 `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataRes) Reset() {
+	*x = ExportUserDataRes{}
+	mi := &file_user_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRes) ProtoMessage() {}
+
+func (x *ExportUserDataRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRes.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ExportUserDataRes) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *ExportUserDataRes) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+type ErasureRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubjectHash   string                 `protobuf:"bytes,2,opt,name=subject_hash,json=subjectHash,proto3" json:"subject_hash,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,3,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Steps         map[string]int64       `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Receipt       string                 `protobuf:"bytes,6,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErasureRecord) Reset() {
+	*x = ErasureRecord{}
+	mi := &file_user_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErasureRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErasureRecord) ProtoMessage() {}
+
+func (x *ErasureRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErasureRecord.ProtoReflect.Descriptor instead.
+func (*ErasureRecord) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ErasureRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ErasureRecord) GetSubjectHash() string {
+	if x != nil {
+		return x.SubjectHash
+	}
+	return ""
+}
+
+func (x *ErasureRecord) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *ErasureRecord) GetSteps() map[string]int64 {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *ErasureRecord) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *ErasureRecord) GetReceipt() string {
+	if x != nil {
+		return x.Receipt
+	}
+	return ""
+}
+
+type EraseUserReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetId      string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseUserReq) Reset() {
+	*x = EraseUserReq{}
+	mi := &file_user_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseUserReq) ProtoMessage() {}
+
+func (x *EraseUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseUserReq.ProtoReflect.Descriptor instead.
+func (*EraseUserReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *EraseUserReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *EraseUserReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EraseUserReq) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+type EraseUserRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Record        *ErasureRecord         `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseUserRes) Reset() {
+	*x = EraseUserRes{}
+	mi := &file_user_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseUserRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseUserRes) ProtoMessage() {}
+
+func (x *EraseUserRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseUserRes.ProtoReflect.Descriptor instead.
+func (*EraseUserRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *EraseUserRes) GetRecord() *ErasureRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+type GetErasureReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetErasureReq) Reset() {
+	*x = GetErasureReq{}
+	mi := &file_user_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetErasureReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetErasureReq) ProtoMessage() {}
+
+func (x *GetErasureReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetErasureReq.ProtoReflect.Descriptor instead.
+func (*GetErasureReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetErasureReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetErasureRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Record        *ErasureRecord         `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetErasureRes) Reset() {
+	*x = GetErasureRes{}
+	mi := &file_user_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetErasureRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetErasureRes) ProtoMessage() {}
+
+func (x *GetErasureRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetErasureRes.ProtoReflect.Descriptor instead.
+func (*GetErasureRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetErasureRes) GetRecord() *ErasureRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+type APIKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	RateLimit     uint32                 `protobuf:"varint,4,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	mi := &file_user_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKey) ProtoMessage() {}
+
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *APIKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *APIKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *APIKey) GetRateLimit() uint32 {
+	if x != nil {
+		return x.RateLimit
+	}
+	return 0
+}
+
+func (x *APIKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+type CreateAPIKeyReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	RateLimit     uint32                 `protobuf:"varint,5,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyReq) Reset() {
+	*x = CreateAPIKeyReq{}
+	mi := &file_user_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyReq) ProtoMessage() {}
+
+func (x *CreateAPIKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyReq.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateAPIKeyReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyReq) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyReq) GetRateLimit() uint32 {
+	if x != nil {
+		return x.RateLimit
+	}
+	return 0
+}
+
+type CreateAPIKeyRes struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   *APIKey                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Shown once, only its hash is stored.
+	Secret        string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyRes) Reset() {
+	*x = CreateAPIKeyRes{}
+	mi := &file_user_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRes) ProtoMessage() {}
+
+func (x *CreateAPIKeyRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyRes.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateAPIKeyRes) GetKey() *APIKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyRes) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type ListAPIKeysReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysReq) Reset() {
+	*x = ListAPIKeysReq{}
+	mi := &file_user_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysReq) ProtoMessage() {}
+
+func (x *ListAPIKeysReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysReq.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListAPIKeysReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListAPIKeysRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*APIKey              `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysRes) Reset() {
+	*x = ListAPIKeysRes{}
+	mi := &file_user_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysRes) ProtoMessage() {}
+
+func (x *ListAPIKeysRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysRes.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListAPIKeysRes) GetKeys() []*APIKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type RevokeAPIKeyReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	KeyId         string                 `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyReq) Reset() {
+	*x = RevokeAPIKeyReq{}
+	mi := &file_user_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyReq) ProtoMessage() {}
+
+func (x *RevokeAPIKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyReq.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RevokeAPIKeyReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RevokeAPIKeyReq) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+type RevokeAPIKeyRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyRes) Reset() {
+	*x = RevokeAPIKeyRes{}
+	mi := &file_user_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRes) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyRes.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{59}
+}
+
+type AuthAPIKeyReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthAPIKeyReq) Reset() {
+	*x = AuthAPIKeyReq{}
+	mi := &file_user_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthAPIKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthAPIKeyReq) ProtoMessage() {}
+
+func (x *AuthAPIKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthAPIKeyReq.ProtoReflect.Descriptor instead.
+func (*AuthAPIKeyReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AuthAPIKeyReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type AuthAPIKeyRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	EmailVerified bool                   `protobuf:"varint,4,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	Permissions   []string               `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	RateLimit     uint32                 `protobuf:"varint,6,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthAPIKeyRes) Reset() {
+	*x = AuthAPIKeyRes{}
+	mi := &file_user_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthAPIKeyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthAPIKeyRes) ProtoMessage() {}
+
+func (x *AuthAPIKeyRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthAPIKeyRes.ProtoReflect.Descriptor instead.
+func (*AuthAPIKeyRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AuthAPIKeyRes) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *AuthAPIKeyRes) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AuthAPIKeyRes) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AuthAPIKeyRes) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *AuthAPIKeyRes) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *AuthAPIKeyRes) GetRateLimit() uint32 {
+	if x != nil {
+		return x.RateLimit
+	}
+	return 0
+}
+
+type OAuthClient struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RedirectUris  []string               `protobuf:"bytes,3,rep,name=redirect_uris,json=redirectUris,proto3" json:"redirect_uris,omitempty"`
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	Confidential  bool                   `protobuf:"varint,5,opt,name=confidential,proto3" json:"confidential,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuthClient) Reset() {
+	*x = OAuthClient{}
+	mi := &file_user_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthClient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthClient) ProtoMessage() {}
+
+func (x *OAuthClient) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthClient.ProtoReflect.Descriptor instead.
+func (*OAuthClient) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *OAuthClient) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OAuthClient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OAuthClient) GetRedirectUris() []string {
+	if x != nil {
+		return x.RedirectUris
+	}
+	return nil
+}
+
+func (x *OAuthClient) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *OAuthClient) GetConfidential() bool {
+	if x != nil {
+		return x.Confidential
+	}
+	return false
+}
+
+func (x *OAuthClient) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type RegisterClientReq struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UserId       string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RedirectUris []string               `protobuf:"bytes,3,rep,name=redirect_uris,json=redirectUris,proto3" json:"redirect_uris,omitempty"`
+	Scopes       []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Confidential clients get a secret and may use client_credentials.
+	Confidential  bool `protobuf:"varint,5,opt,name=confidential,proto3" json:"confidential,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterClientReq) Reset() {
+	*x = RegisterClientReq{}
+	mi := &file_user_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterClientReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterClientReq) ProtoMessage() {}
+
+func (x *RegisterClientReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterClientReq.ProtoReflect.Descriptor instead.
+func (*RegisterClientReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RegisterClientReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RegisterClientReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegisterClientReq) GetRedirectUris() []string {
+	if x != nil {
+		return x.RedirectUris
+	}
+	return nil
+}
+
+func (x *RegisterClientReq) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *RegisterClientReq) GetConfidential() bool {
+	if x != nil {
+		return x.Confidential
+	}
+	return false
+}
+
+type RegisterClientRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Client        *OAuthClient           `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	ClientSecret  string                 `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterClientRes) Reset() {
+	*x = RegisterClientRes{}
+	mi := &file_user_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterClientRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterClientRes) ProtoMessage() {}
+
+func (x *RegisterClientRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterClientRes.ProtoReflect.Descriptor instead.
+func (*RegisterClientRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RegisterClientRes) GetClient() *OAuthClient {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *RegisterClientRes) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+type ListClientsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClientsReq) Reset() {
+	*x = ListClientsReq{}
+	mi := &file_user_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClientsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientsReq) ProtoMessage() {}
+
+func (x *ListClientsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientsReq.ProtoReflect.Descriptor instead.
+func (*ListClientsReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListClientsReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListClientsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Clients       []*OAuthClient         `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClientsRes) Reset() {
+	*x = ListClientsRes{}
+	mi := &file_user_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClientsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientsRes) ProtoMessage() {}
+
+func (x *ListClientsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientsRes.ProtoReflect.Descriptor instead.
+func (*ListClientsRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListClientsRes) GetClients() []*OAuthClient {
+	if x != nil {
+		return x.Clients
+	}
+	return nil
+}
+
+type DeleteClientReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClientReq) Reset() {
+	*x = DeleteClientReq{}
+	mi := &file_user_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClientReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClientReq) ProtoMessage() {}
+
+func (x *DeleteClientReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClientReq.ProtoReflect.Descriptor instead.
+func (*DeleteClientReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteClientReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteClientReq) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type DeleteClientRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClientRes) Reset() {
+	*x = DeleteClientRes{}
+	mi := &file_user_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClientRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClientRes) ProtoMessage() {}
+
+func (x *DeleteClientRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClientRes.ProtoReflect.Descriptor instead.
+func (*DeleteClientRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{68}
+}
+
+type AuthorizeReq struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role                string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	ResponseType        string                 `protobuf:"bytes,3,opt,name=response_type,json=responseType,proto3" json:"response_type,omitempty"`
+	ClientId            string                 `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	RedirectUri         string                 `protobuf:"bytes,5,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	Scope               string                 `protobuf:"bytes,6,opt,name=scope,proto3" json:"scope,omitempty"`
+	State               string                 `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	CodeChallenge       string                 `protobuf:"bytes,8,opt,name=code_challenge,json=codeChallenge,proto3" json:"code_challenge,omitempty"`
+	CodeChallengeMethod string                 `protobuf:"bytes,9,opt,name=code_challenge_method,json=codeChallengeMethod,proto3" json:"code_challenge_method,omitempty"`
+	Nonce               string                 `protobuf:"bytes,10,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Set once the user accepted the consent screen.
+	Approve bool `protobuf:"varint,11,opt,name=approve,proto3" json:"approve,omitempty"`
+	// Set while an admin impersonates user_id, such sessions are refused.
+	ActorId       string `protobuf:"bytes,12,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizeReq) Reset() {
+	*x = AuthorizeReq{}
+	mi := &file_user_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeReq) ProtoMessage() {}
+
+func (x *AuthorizeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeReq.ProtoReflect.Descriptor instead.
+func (*AuthorizeReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AuthorizeReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AuthorizeReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AuthorizeReq) GetResponseType() string {
+	if x != nil {
+		return x.ResponseType
+	}
+	return ""
+}
+
+func (x *AuthorizeReq) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *AuthorizeReq) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+func (x *AuthorizeReq) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *AuthorizeReq) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *AuthorizeReq) GetCodeChallenge() string {
+	if x != nil {
+		return x.CodeChallenge
+	}
+	return ""
+}
+
+func (x *AuthorizeReq) GetCodeChallengeMethod() string {
+	if x != nil {
+		return x.CodeChallengeMethod
+	}
+	return ""
+}
+
+func (x *AuthorizeReq) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *AuthorizeReq) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *AuthorizeReq) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type AuthorizeRes struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConsentRequired bool                   `protobuf:"varint,1,opt,name=consent_required,json=consentRequired,proto3" json:"consent_required,omitempty"`
+	ClientName      string                 `protobuf:"bytes,2,opt,name=client_name,json=clientName,proto3" json:"client_name,omitempty"`
+	Scopes          []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Where to send the browser, carrying code and state.
+	RedirectTo    string `protobuf:"bytes,4,opt,name=redirect_to,json=redirectTo,proto3" json:"redirect_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizeRes) Reset() {
+	*x = AuthorizeRes{}
+	mi := &file_user_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizeRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeRes) ProtoMessage() {}
+
+func (x *AuthorizeRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeRes.ProtoReflect.Descriptor instead.
+func (*AuthorizeRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *AuthorizeRes) GetConsentRequired() bool {
+	if x != nil {
+		return x.ConsentRequired
+	}
+	return false
+}
+
+func (x *AuthorizeRes) GetClientName() string {
+	if x != nil {
+		return x.ClientName
+	}
+	return ""
+}
+
+func (x *AuthorizeRes) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *AuthorizeRes) GetRedirectTo() string {
+	if x != nil {
+		return x.RedirectTo
+	}
+	return ""
+}
+
+type TokenReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GrantType     string                 `protobuf:"bytes,1,opt,name=grant_type,json=grantType,proto3" json:"grant_type,omitempty"`
+	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret  string                 `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	Code          string                 `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	RedirectUri   string                 `protobuf:"bytes,5,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	CodeVerifier  string                 `protobuf:"bytes,6,opt,name=code_verifier,json=codeVerifier,proto3" json:"code_verifier,omitempty"`
+	Scope         string                 `protobuf:"bytes,7,opt,name=scope,proto3" json:"scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenReq) Reset() {
+	*x = TokenReq{}
+	mi := &file_user_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenReq) ProtoMessage() {}
+
+func (x *TokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenReq.ProtoReflect.Descriptor instead.
+func (*TokenReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *TokenReq) GetGrantType() string {
+	if x != nil {
+		return x.GrantType
+	}
+	return ""
+}
+
+func (x *TokenReq) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *TokenReq) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *TokenReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *TokenReq) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+func (x *TokenReq) GetCodeVerifier() string {
+	if x != nil {
+		return x.CodeVerifier
+	}
+	return ""
+}
+
+func (x *TokenReq) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+type TokenRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	TokenType     string                 `protobuf:"bytes,2,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	Scope         string                 `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	IdToken       string                 `protobuf:"bytes,5,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenRes) Reset() {
+	*x = TokenRes{}
+	mi := &file_user_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenRes) ProtoMessage() {}
+
+func (x *TokenRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenRes.ProtoReflect.Descriptor instead.
+func (*TokenRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *TokenRes) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *TokenRes) GetTokenType() string {
+	if x != nil {
+		return x.TokenType
+	}
+	return ""
+}
+
+func (x *TokenRes) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *TokenRes) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *TokenRes) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+type AuthAccessTokenReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthAccessTokenReq) Reset() {
+	*x = AuthAccessTokenReq{}
+	mi := &file_user_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthAccessTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthAccessTokenReq) ProtoMessage() {}
+
+func (x *AuthAccessTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthAccessTokenReq.ProtoReflect.Descriptor instead.
+func (*AuthAccessTokenReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AuthAccessTokenReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type AuthAccessTokenRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	EmailVerified bool                   `protobuf:"varint,3,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	Permissions   []string               `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	ClientId      string                 `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthAccessTokenRes) Reset() {
+	*x = AuthAccessTokenRes{}
+	mi := &file_user_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthAccessTokenRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthAccessTokenRes) ProtoMessage() {}
+
+func (x *AuthAccessTokenRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthAccessTokenRes.ProtoReflect.Descriptor instead.
+func (*AuthAccessTokenRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AuthAccessTokenRes) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AuthAccessTokenRes) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AuthAccessTokenRes) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *AuthAccessTokenRes) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *AuthAccessTokenRes) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type OAuthUserInfoReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuthUserInfoReq) Reset() {
+	*x = OAuthUserInfoReq{}
+	mi := &file_user_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthUserInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthUserInfoReq) ProtoMessage() {}
+
+func (x *OAuthUserInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthUserInfoReq.ProtoReflect.Descriptor instead.
+func (*OAuthUserInfoReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *OAuthUserInfoReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type OAuthUserInfoRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sub           string                 `protobuf:"bytes,1,opt,name=sub,proto3" json:"sub,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	EmailVerified bool                   `protobuf:"varint,4,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	Scopes        []string               `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuthUserInfoRes) Reset() {
+	*x = OAuthUserInfoRes{}
+	mi := &file_user_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthUserInfoRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthUserInfoRes) ProtoMessage() {}
+
+func (x *OAuthUserInfoRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuthUserInfoRes.ProtoReflect.Descriptor instead.
+func (*OAuthUserInfoRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *OAuthUserInfoRes) GetSub() string {
+	if x != nil {
+		return x.Sub
+	}
+	return ""
+}
+
+func (x *OAuthUserInfoRes) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OAuthUserInfoRes) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *OAuthUserInfoRes) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *OAuthUserInfoRes) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+// IntrospectReq is asked by a confidential client (RFC 7662).
+type IntrospectReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	TokenTypeHint string                 `protobuf:"bytes,2,opt,name=token_type_hint,json=tokenTypeHint,proto3" json:"token_type_hint,omitempty"`
+	ClientId      string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret  string                 `protobuf:"bytes,4,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntrospectReq) Reset() {
+	*x = IntrospectReq{}
+	mi := &file_user_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntrospectReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntrospectReq) ProtoMessage() {}
+
+func (x *IntrospectReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntrospectReq.ProtoReflect.Descriptor instead.
+func (*IntrospectReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *IntrospectReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *IntrospectReq) GetTokenTypeHint() string {
+	if x != nil {
+		return x.TokenTypeHint
+	}
+	return ""
+}
+
+func (x *IntrospectReq) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *IntrospectReq) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+type IntrospectRes struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Active bool                   `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// "access_token" for OAuth tokens, "session_token" for login JWTs.
+	TokenType     string `protobuf:"bytes,2,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	Sub           string `protobuf:"bytes,3,opt,name=sub,proto3" json:"sub,omitempty"`
+	ClientId      string `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Scope         string `protobuf:"bytes,5,opt,name=scope,proto3" json:"scope,omitempty"`
+	Exp           int64  `protobuf:"varint,6,opt,name=exp,proto3" json:"exp,omitempty"`
+	Iat           int64  `protobuf:"varint,7,opt,name=iat,proto3" json:"iat,omitempty"`
+	Jti           string `protobuf:"bytes,8,opt,name=jti,proto3" json:"jti,omitempty"`
+	Iss           string `protobuf:"bytes,9,opt,name=iss,proto3" json:"iss,omitempty"`
+	Role          string `protobuf:"bytes,10,opt,name=role,proto3" json:"role,omitempty"`
+	Act           string `protobuf:"bytes,11,opt,name=act,proto3" json:"act,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntrospectRes) Reset() {
+	*x = IntrospectRes{}
+	mi := &file_user_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntrospectRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntrospectRes) ProtoMessage() {}
+
+func (x *IntrospectRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntrospectRes.ProtoReflect.Descriptor instead.
+func (*IntrospectRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *IntrospectRes) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *IntrospectRes) GetTokenType() string {
+	if x != nil {
+		return x.TokenType
+	}
+	return ""
+}
+
+func (x *IntrospectRes) GetSub() string {
+	if x != nil {
+		return x.Sub
+	}
+	return ""
+}
+
+func (x *IntrospectRes) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *IntrospectRes) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *IntrospectRes) GetExp() int64 {
+	if x != nil {
+		return x.Exp
+	}
+	return 0
+}
+
+func (x *IntrospectRes) GetIat() int64 {
+	if x != nil {
+		return x.Iat
+	}
+	return 0
+}
+
+func (x *IntrospectRes) GetJti() string {
+	if x != nil {
+		return x.Jti
+	}
+	return ""
+}
+
+func (x *IntrospectRes) GetIss() string {
+	if x != nil {
+		return x.Iss
+	}
+	return ""
+}
+
+func (x *IntrospectRes) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *IntrospectRes) GetAct() string {
+	if x != nil {
+		return x.Act
+	}
+	return ""
+}
+
+// RevokeTokenReq comes either from an OAuth client revoking its own
+// token (RFC 7009) or from a logged in user.
+type RevokeTokenReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	TokenTypeHint string                 `protobuf:"bytes,2,opt,name=token_type_hint,json=tokenTypeHint,proto3" json:"token_type_hint,omitempty"`
+	ClientId      string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret  string                 `protobuf:"bytes,4,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	UserId        string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Permissions   []string               `protobuf:"bytes,7,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTokenReq) Reset() {
+	*x = RevokeTokenReq{}
+	mi := &file_user_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTokenReq) ProtoMessage() {}
+
+func (x *RevokeTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTokenReq.ProtoReflect.Descriptor instead.
+func (*RevokeTokenReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RevokeTokenReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RevokeTokenReq) GetTokenTypeHint() string {
+	if x != nil {
+		return x.TokenTypeHint
+	}
+	return ""
+}
+
+func (x *RevokeTokenReq) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *RevokeTokenReq) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *RevokeTokenReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RevokeTokenReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RevokeTokenReq) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type RevokeTokenRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTokenRes) Reset() {
+	*x = RevokeTokenRes{}
+	mi := &file_user_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTokenRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTokenRes) ProtoMessage() {}
+
+func (x *RevokeTokenRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTokenRes.ProtoReflect.Descriptor instead.
+func (*RevokeTokenRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{80}
+}
+
+type SSOStartReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SSOStartReq) Reset() {
+	*x = SSOStartReq{}
+	mi := &file_user_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSOStartReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSOStartReq) ProtoMessage() {}
+
+func (x *SSOStartReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSOStartReq.ProtoReflect.Descriptor instead.
+func (*SSOStartReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SSOStartReq) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type SSOStartRes struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	AuthUrl string                 `protobuf:"bytes,1,opt,name=auth_url,json=authUrl,proto3" json:"auth_url,omitempty"`
+	// state to pin to the browser, the callback must bring it back
+	State         string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SSOStartRes) Reset() {
+	*x = SSOStartRes{}
+	mi := &file_user_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSOStartRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSOStartRes) ProtoMessage() {}
+
+func (x *SSOStartRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSOStartRes.ProtoReflect.Descriptor instead.
+func (*SSOStartRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SSOStartRes) GetAuthUrl() string {
+	if x != nil {
+		return x.AuthUrl
+	}
+	return ""
+}
+
+func (x *SSOStartRes) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type SSOCallbackReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SSOCallbackReq) Reset() {
+	*x = SSOCallbackReq{}
+	mi := &file_user_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSOCallbackReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSOCallbackReq) ProtoMessage() {}
+
+func (x *SSOCallbackReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSOCallbackReq.ProtoReflect.Descriptor instead.
+func (*SSOCallbackReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SSOCallbackReq) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *SSOCallbackReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SSOCallbackReq) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type JWKSReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JWKSReq) Reset() {
+	*x = JWKSReq{}
+	mi := &file_user_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JWKSReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWKSReq) ProtoMessage() {}
+
+func (x *JWKSReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWKSReq.ProtoReflect.Descriptor instead.
+func (*JWKSReq) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{84}
+}
+
+type JWKSRes struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// JSON Web Key Set, served as is.
+	Jwks          string `protobuf:"bytes,1,opt,name=jwks,proto3" json:"jwks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JWKSRes) Reset() {
+	*x = JWKSRes{}
+	mi := &file_user_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JWKSRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWKSRes) ProtoMessage() {}
+
+func (x *JWKSRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWKSRes.ProtoReflect.Descriptor instead.
+func (*JWKSRes) Descriptor() ([]byte, []int) {
+	return file_user_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *JWKSRes) GetJwks() string {
+	if x != nil {
+		return x.Jwks
+	}
+	return ""
+}
+
+var File_user_service_proto protoreflect.FileDescriptor
+
+const file_user_service_proto_rawDesc = "" +
+	"\n" +
+	"\x12user-service.proto\x12\x05users\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xad\x01\n" +
+	"\x06RegReq\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x02\x182R\x04name\x12\x1d\n" +
+	"\x05email\x18\x02 \x01(\tB\a\xfaB\x04r\x02`\x01R\x05email\x12\x16\n" +
+	"\x04role\x18\x03 \x01(\tB\x02\x18\x01R\x04role\x12#\n" +
+	"\bpassword\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\bR\bpassword\x12(\n" +
+	"\vinvite_code\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x18@R\n" +
+	"inviteCode\"R\n" +
+	"\x06RegRes\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10dR\x05token\x12)\n" +
+	"\vsession_key\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"sessionKey\"\x9a\x01\n" +
+	"\x06LogReq\x12 \n" +
+	"\x04name\x18\x01 \x01(\tB\f\xfaB\tr\a\x10\x02\x182\xd0\x01\x01R\x04name\x12 \n" +
+	"\x05email\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\xd0\x01\x01`\x01R\x05email\x12#\n" +
+	"\bpassword\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\bR\bpassword\x12'\n" +
+	"\tclient_ip\x18\x04 \x01(\tB\n" +
+	"\xfaB\ar\x05\xd0\x01\x01p\x01R\bclientIp\"\xc0\x01\n" +
+	"\x06LogRes\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10dR\x05token\x12)\n" +
+	"\vsession_key\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"sessionKey\x12!\n" +
+	"\fmfa_required\x18\x03 \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\x04 \x01(\tR\bmfaToken\x12,\n" +
+	"\x12mfa_enrol_required\x18\x05 \x01(\bR\x10mfaEnrolRequired\"T\n" +
+	"\fVerifyMFAReq\x12%\n" +
+	"\tmfa_token\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\bmfaToken\x12\x1d\n" +
+	"\x04code\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x06\x18\x10R\x04code\"X\n" +
+	"\fVerifyMFARes\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10dR\x05token\x12)\n" +
+	"\vsession_key\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"sessionKey\"Y\n" +
+	"\rExtJWTDataReq\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10dR\x05token\x12)\n" +
+	"\vsession_key\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"sessionKey\"\xca\x01\n" +
+	"\rExtJWTDataRes\x12\x1d\n" +
+	"\x04role\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04role\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\x05token\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10dR\x05token\x12%\n" +
+	"\x0eemail_verified\x18\x04 \x01(\bR\remailVerified\x12 \n" +
+	"\vpermissions\x18\x05 \x03(\tR\vpermissions\x12\x19\n" +
+	"\bactor_id\x18\x06 \x01(\tR\aactorId\"\xbb\x01\n" +
+	"\n" +
+	"DelUserReq\x12\x1d\n" +
+	"\x04role\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04role\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12\x1e\n" +
+	"\vdel_user_id\x18\x03 \x01(\tR\tdelUserId\x12)\n" +
+	"\vsession_key\x18\x04 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"sessionKey\x12 \n" +
+	"\vpermissions\x18\x05 \x03(\tR\vpermissions\"-\n" +
+	"\n" +
+	"DelUserRes\x12\x1f\n" +
+	"\vdeletion_id\x18\x01 \x01(\tR\n" +
+	"deletionId\"0\n" +
+	"\x0eVerifyEmailReq\x12\x1e\n" +
+	"\x05token\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x05token\"\x10\n" +
+	"\x0eVerifyEmailRes\":\n" +
+	"\x15ResendVerificationReq\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\"\x17\n" +
+	"\x15ResendVerificationRes\"_\n" +
+	"\rEnrollTOTPReq\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\x06userId\x12(\n" +
+	"\tmfa_token\x18\x02 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\bmfaToken\"H\n" +
+	"\rEnrollTOTPRes\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x1f\n" +
+	"\votpauth_uri\x18\x02 \x01(\tR\n" +
+	"otpauthUri\"~\n" +
+	"\x0eConfirmTOTPReq\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\x06userId\x12(\n" +
+	"\tmfa_token\x18\x02 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\bmfaToken\x12\x1c\n" +
+	"\x04code\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x98\x01\x06R\x04code\"n\n" +
+	"\x0eConfirmTOTPRes\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x1f\n" +
+	"\vsession_key\x18\x03 \x01(\tR\n" +
+	"sessionKey\"\x98\x01\n" +
+	"\rSetMFARoleReq\x12\x1d\n" +
+	"\x04role\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04role\x12*\n" +
+	"\vtarget_role\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\n" +
+	"targetRole\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\x12 \n" +
+	"\vpermissions\x18\x04 \x03(\tR\vpermissions\"\x0f\n" +
+	"\rSetMFARoleRes\"s\n" +
+	"\rUnlockUserReq\x12\x1d\n" +
+	"\x04role\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04role\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\"\x0f\n" +
+	"\rUnlockUserRes\"\xd0\x02\n" +
+	"\vUserProfile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12%\n" +
+	"\x0eemail_verified\x18\x05 \x01(\bR\remailVerified\x12!\n" +
+	"\ftotp_enabled\x18\x06 \x01(\bR\vtotpEnabled\x12!\n" +
+	"\fdisplay_name\x18\a \x01(\tR\vdisplayName\x12\x10\n" +
+	"\x03bio\x18\b \x01(\tR\x03bio\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x97\x01\n" +
+	"\n" +
+	"GetUserReq\x12\x1d\n" +
+	"\x04role\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04role\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\ttarget_id\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\btargetId\x12 \n" +
+	"\vpermissions\x18\x04 \x03(\tR\vpermissions\"4\n" +
+	"\n" +
+	"GetUserRes\x12&\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.users.UserProfileR\x04user\"\xf8\x01\n" +
+	"\rUpdateUserReq\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12\"\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x02\x182H\x00R\x04name\x88\x01\x01\x12\"\n" +
+	"\x05email\x18\x03 \x01(\tB\a\xfaB\x04r\x02`\x01H\x01R\x05email\x88\x01\x01\x12/\n" +
+	"\fdisplay_name\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x18dH\x02R\vdisplayName\x88\x01\x01\x12\x1f\n" +
+	"\x03bio\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\xe8\aH\x03R\x03bio\x88\x01\x01B\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_emailB\x0f\n" +
+	"\r_display_nameB\x06\n" +
+	"\x04_bio\"7\n" +
+	"\rUpdateUserRes\x12&\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.users.UserProfileR\x04user\"\xd4\x02\n" +
+	"\fListUsersReq\x12\x1d\n" +
+	"\x04role\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04role\x12\x1d\n" +
+	"\x05query\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18dR\x05query\x12(\n" +
+	"\vrole_filter\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x182R\n" +
+	"roleFilter\x12=\n" +
+	"\fcreated_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12 \n" +
+	"\x06cursor\x18\x06 \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\x06cursor\x12\x1e\n" +
+	"\x05limit\x18\a \x01(\rB\b\xfaB\x05*\x03\x18\xc8\x01R\x05limit\x12 \n" +
+	"\vpermissions\x18\b \x03(\tR\vpermissions\"Y\n" +
+	"\fListUsersRes\x12(\n" +
+	"\x05users\x18\x01 \x03(\v2\x12.users.UserProfileR\x05users\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xc1\x01\n" +
+	"\x0eSetUserRoleReq\x12\x1d\n" +
+	"\x04role\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04role\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\ttarget_id\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\btargetId\x12$\n" +
+	"\bnew_role\x18\x04 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\anewRole\x12 \n" +
+	"\vpermissions\x18\x05 \x03(\tR\vpermissions\"\x10\n" +
+	"\x0eSetUserRoleRes\"\xbf\x01\n" +
+	"\x0eImpersonateReq\x12\x1d\n" +
+	"\x04role\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04role\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\ttarget_id\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\btargetId\x12\"\n" +
+	"\x06reason\x18\x04 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x03\x18\xf4\x03R\x06reason\x12 \n" +
+	"\vpermissions\x18\x05 \x03(\tR\vpermissions\"f\n" +
+	"\x0eImpersonateRes\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1f\n" +
+	"\vsession_key\x18\x02 \x01(\tR\n" +
+	"sessionKey\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"\xb9\x03\n" +
+	"\bDeletion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
+	"\frequested_by\x18\x03 \x01(\tR\vrequestedBy\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x1a\n" +
+	"\battempts\x18\x05 \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\x12)\n" +
+	"\x10orders_cancelled\x18\a \x01(\x03R\x0fordersCancelled\x12+\n" +
+	"\x11orders_anonymised\x18\b \x01(\x03R\x10ordersAnonymised\x12B\n" +
+	"\x0fnext_attempt_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa1\x01\n" +
+	"\x10ListDeletionsReq\x12\x1d\n" +
+	"\x04role\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04role\x12\x1b\n" +
+	"\x02id\x18\x02 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\x02id\x12\x10\n" +
+	"\x03all\x18\x03 \x01(\bR\x03all\x12\x1d\n" +
+	"\x05limit\x18\x04 \x01(\rB\a\xfaB\x04*\x02\x18dR\x05limit\x12 \n" +
+	"\vpermissions\x18\x05 \x03(\tR\vpermissions\"A\n" +
+	"\x10ListDeletionsRes\x12-\n" +
+	"\tdeletions\x18\x01 \x03(\v2\x0f.users.DeletionR\tdeletions\"\xbf\x02\n" +
+	"\x06Invite\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12\x19\n" +
+	"\bmax_uses\x18\x04 \x01(\x05R\amaxUses\x12\x12\n" +
+	"\x04uses\x18\x05 \x01(\x05R\x04uses\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\"\x8f\x02\n" +
+	"\x0fCreateInviteReq\x12\x1d\n" +
+	"\x04role\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04role\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12*\n" +
+	"\vinvite_role\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\n" +
+	"inviteRole\x12\x1c\n" +
+	"\x04note\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\x04note\x12%\n" +
+	"\bmax_uses\x18\x05 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\x90N(\x01R\amaxUses\x12'\n" +
+	"\tttl_hours\x18\x06 \x01(\rB\n" +
+	"\xfaB\a*\x05\x18\xb8D(\x01R\bttlHours\x12 \n" +
+	"\vpermissions\x18\a \x03(\tR\vpermissions\"L\n" +
+	"\x0fCreateInviteRes\x12%\n" +
+	"\x06invite\x18\x01 \x01(\v2\r.users.InviteR\x06invite\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"c\n" +
+	"\x0eListInvitesReq\x12\x1d\n" +
+	"\x04role\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04role\x12\x10\n" +
+	"\x03all\x18\x02 \x01(\bR\x03all\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\"9\n" +
+	"\x0eListInvitesRes\x12'\n" +
+	"\ainvites\x18\x01 \x03(\v2\r.users.InviteR\ainvites\"v\n" +
+	"\n" +
+	"Redemption\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12;\n" +
+	"\vredeemed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"redeemedAt\"|\n" +
+	"\x12ListRedemptionsReq\x12\x1d\n" +
+	"\x04role\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04role\x12%\n" +
+	"\tinvite_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\binviteId\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\"I\n" +
+	"\x12ListRedemptionsRes\x123\n" +
+	"\vredemptions\x18\x01 \x03(\v2\x11.users.RedemptionR\vredemptions\"\x9c\x01\n" +
+	"\x0fRevokeInviteReq\x12\x1d\n" +
+	"\x04role\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04role\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\tinvite_id\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\binviteId\x12 \n" +
+	"\vpermissions\x18\x04 \x03(\tR\vpermissions\"\x11\n" +
+	"\x0fRevokeInviteRes\"|\n" +
+	"\x11ExportUserDataReq\x12\x1d\n" +
+	"\x04role\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04role\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\ttarget_id\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\btargetId\"I\n" +
+	"\x11ExportUserDataRes\x12\x18\n" +
+	"\aarchive\x18\x01 \x01(\fR\aarchive\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\"\xaf\x02\n" +
+	"\rErasureRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fsubject_hash\x18\x02 \x01(\tR\vsubjectHash\x12!\n" +
+	"\frequested_by\x18\x03 \x01(\tR\vrequestedBy\x125\n" +
+	"\x05steps\x18\x04 \x03(\v2\x1f.users.ErasureRecord.StepsEntryR\x05steps\x12=\n" +
+	"\fcompleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x18\n" +
+	"\areceipt\x18\x06 \x01(\tR\areceipt\x1a8\n" +
+	"\n" +
+	"StepsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"w\n" +
+	"\fEraseUserReq\x12\x1d\n" +
+	"\x04role\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04role\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\ttarget_id\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\btargetId\"<\n" +
+	"\fEraseUserRes\x12,\n" +
+	"\x06record\x18\x01 \x01(\v2\x14.users.ErasureRecordR\x06record\")\n" +
+	"\rGetErasureReq\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\"=\n" +
+	"\rGetErasureRes\x12,\n" +
+	"\x06record\x18\x01 \x01(\v2\x14.users.ErasureRecordR\x06record\"\xdc\x01\n" +
+	"\x06APIKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12\x1d\n" +
+	"\n" +
+	"rate_limit\x18\x04 \x01(\rR\trateLimit\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"\xbf\x01\n" +
+	"\x0fCreateAPIKeyReq\x12\x1d\n" +
+	"\x04role\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04role\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12\x1d\n" +
+	"\x04name\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04name\x12\"\n" +
+	"\x06scopes\x18\x04 \x03(\tB\n" +
+	"\xfaB\a\x92\x01\x04\b\x01\x18\x01R\x06scopes\x12'\n" +
+	"\n" +
+	"rate_limit\x18\x05 \x01(\rB\b\xfaB\x05*\x03\x18\x90NR\trateLimit\"J\n" +
+	"\x0fCreateAPIKeyRes\x12\x1f\n" +
+	"\x03key\x18\x01 \x01(\v2\r.users.APIKeyR\x03key\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"3\n" +
+	"\x0eListAPIKeysReq\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\"3\n" +
+	"\x0eListAPIKeysRes\x12!\n" +
+	"\x04keys\x18\x01 \x03(\v2\r.users.APIKeyR\x04keys\"U\n" +
+	"\x0fRevokeAPIKeyReq\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12\x1f\n" +
+	"\x06key_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x05keyId\"\x11\n" +
+	"\x0fRevokeAPIKeyRes\"-\n" +
+	"\rAuthAPIKeyReq\x12\x1c\n" +
+	"\x03key\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xc8\x01R\x03key\"\xbb\x01\n" +
+	"\rAuthAPIKeyRes\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12%\n" +
+	"\x0eemail_verified\x18\x04 \x01(\bR\remailVerified\x12 \n" +
+	"\vpermissions\x18\x05 \x03(\tR\vpermissions\x12\x1d\n" +
+	"\n" +
+	"rate_limit\x18\x06 \x01(\rR\trateLimit\"\xcd\x01\n" +
+	"\vOAuthClient\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rredirect_uris\x18\x03 \x03(\tR\fredirectUris\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12\"\n" +
+	"\fconfidential\x18\x05 \x01(\bR\fconfidential\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd7\x01\n" +
+	"\x11RegisterClientReq\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\x04name\x128\n" +
+	"\rredirect_uris\x18\x03 \x03(\tB\x13\xfaB\x10\x92\x01\r\b\x01\x10\n" +
+	"\x18\x01\"\x05r\x03\x88\x01\x01R\fredirectUris\x12\"\n" +
+	"\x06scopes\x18\x04 \x03(\tB\n" +
+	"\xfaB\a\x92\x01\x04\b\x01\x18\x01R\x06scopes\x12\"\n" +
+	"\fconfidential\x18\x05 \x01(\bR\fconfidential\"d\n" +
+	"\x11RegisterClientRes\x12*\n" +
+	"\x06client\x18\x01 \x01(\v2\x12.users.OAuthClientR\x06client\x12#\n" +
+	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\"3\n" +
+	"\x0eListClientsReq\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\">\n" +
+	"\x0eListClientsRes\x12,\n" +
+	"\aclients\x18\x01 \x03(\v2\x12.users.OAuthClientR\aclients\"[\n" +
+	"\x0fDeleteClientReq\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\tclient_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\bclientId\"\x11\n" +
+	"\x0fDeleteClientRes\"\xe1\x03\n" +
+	"\fAuthorizeReq\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12\x1d\n" +
+	"\x04role\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04role\x120\n" +
+	"\rresponse_type\x18\x03 \x01(\tB\v\xfaB\br\x06\n" +
+	"\x04codeR\fresponseType\x12%\n" +
+	"\tclient_id\x18\x04 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\bclientId\x12+\n" +
+	"\fredirect_uri\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01R\vredirectUri\x12 \n" +
+	"\x05scope\x18\x06 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xf4\x03R\x05scope\x12\x1e\n" +
+	"\x05state\x18\a \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\x05state\x121\n" +
+	"\x0ecode_challenge\x18\b \x01(\tB\n" +
+	"\xfaB\ar\x05\x10+\x18\x80\x01R\rcodeChallenge\x12?\n" +
+	"\x15code_challenge_method\x18\t \x01(\tB\v\xfaB\br\x06\n" +
+	"\x04S256R\x13codeChallengeMethod\x12\x1e\n" +
+	"\x05nonce\x18\n" +
+	" \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\x05nonce\x12\x18\n" +
+	"\aapprove\x18\v \x01(\bR\aapprove\x12\x19\n" +
+	"\bactor_id\x18\f \x01(\tR\aactorId\"\x93\x01\n" +
+	"\fAuthorizeRes\x12)\n" +
+	"\x10consent_required\x18\x01 \x01(\bR\x0fconsentRequired\x12\x1f\n" +
+	"\vclient_name\x18\x02 \x01(\tR\n" +
+	"clientName\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12\x1f\n" +
+	"\vredirect_to\x18\x04 \x01(\tR\n" +
+	"redirectTo\"\xc8\x02\n" +
+	"\bTokenReq\x12L\n" +
+	"\n" +
+	"grant_type\x18\x01 \x01(\tB-\xfaB*r(R\x12authorization_codeR\x12client_credentialsR\tgrantType\x12%\n" +
+	"\tclient_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\bclientId\x12-\n" +
+	"\rclient_secret\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\fclientSecret\x12\x1c\n" +
+	"\x04code\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\x04code\x12+\n" +
+	"\fredirect_uri\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\xd0\x0fR\vredirectUri\x12-\n" +
+	"\rcode_verifier\x18\x06 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x01R\fcodeVerifier\x12\x1e\n" +
+	"\x05scope\x18\a \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\x05scope\"\x9c\x01\n" +
+	"\bTokenRes\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12\x1d\n" +
+	"\n" +
+	"token_type\x18\x02 \x01(\tR\ttokenType\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\x12\x14\n" +
+	"\x05scope\x18\x04 \x01(\tR\x05scope\x12\x19\n" +
+	"\bid_token\x18\x05 \x01(\tR\aidToken\"6\n" +
+	"\x12AuthAccessTokenReq\x12 \n" +
+	"\x05token\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80 R\x05token\"\xa7\x01\n" +
+	"\x12AuthAccessTokenRes\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12%\n" +
+	"\x0eemail_verified\x18\x03 \x01(\bR\remailVerified\x12 \n" +
+	"\vpermissions\x18\x04 \x03(\tR\vpermissions\x12\x1b\n" +
+	"\tclient_id\x18\x05 \x01(\tR\bclientId\"4\n" +
+	"\x10OAuthUserInfoReq\x12 \n" +
+	"\x05token\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80 R\x05token\"\x8d\x01\n" +
+	"\x10OAuthUserInfoRes\x12\x10\n" +
+	"\x03sub\x18\x01 \x01(\tR\x03sub\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12%\n" +
+	"\x0eemail_verified\x18\x04 \x01(\bR\remailVerified\x12\x16\n" +
+	"\x06scopes\x18\x05 \x03(\tR\x06scopes\"\xb8\x01\n" +
+	"\rIntrospectReq\x12 \n" +
+	"\x05token\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80 R\x05token\x12/\n" +
+	"\x0ftoken_type_hint\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x182R\rtokenTypeHint\x12%\n" +
+	"\tclient_id\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\bclientId\x12-\n" +
+	"\rclient_secret\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\fclientSecret\"\xf9\x01\n" +
+	"\rIntrospectRes\x12\x16\n" +
+	"\x06active\x18\x01 \x01(\bR\x06active\x12\x1d\n" +
+	"\n" +
+	"token_type\x18\x02 \x01(\tR\ttokenType\x12\x10\n" +
+	"\x03sub\x18\x03 \x01(\tR\x03sub\x12\x1b\n" +
+	"\tclient_id\x18\x04 \x01(\tR\bclientId\x12\x14\n" +
+	"\x05scope\x18\x05 \x01(\tR\x05scope\x12\x10\n" +
+	"\x03exp\x18\x06 \x01(\x03R\x03exp\x12\x10\n" +
+	"\x03iat\x18\a \x01(\x03R\x03iat\x12\x10\n" +
+	"\x03jti\x18\b \x01(\tR\x03jti\x12\x10\n" +
+	"\x03iss\x18\t \x01(\tR\x03iss\x12\x12\n" +
+	"\x04role\x18\n" +
+	" \x01(\tR\x04role\x12\x10\n" +
+	"\x03act\x18\v \x01(\tR\x03act\"\xa1\x02\n" +
+	"\x0eRevokeTokenReq\x12 \n" +
+	"\x05token\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80 R\x05token\x12/\n" +
+	"\x0ftoken_type_hint\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x182R\rtokenTypeHint\x12(\n" +
+	"\tclient_id\x18\x03 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\bclientId\x12-\n" +
+	"\rclient_secret\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\fclientSecret\x12\x1b\n" +
+	"\x04role\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x182R\x04role\x12$\n" +
+	"\auser_id\x18\x06 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01R\x06userId\x12 \n" +
+	"\vpermissions\x18\a \x03(\tR\vpermissions\"\x10\n" +
+	"\x0eRevokeTokenRes\"4\n" +
+	"\vSSOStartReq\x12%\n" +
+	"\bprovider\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\bprovider\">\n" +
+	"\vSSOStartRes\x12\x19\n" +
+	"\bauth_url\x18\x01 \x01(\tR\aauthUrl\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\"w\n" +
+	"\x0eSSOCallbackReq\x12%\n" +
+	"\bprovider\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\bprovider\x12\x1e\n" +
+	"\x04code\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xd0\x0fR\x04code\x12\x1e\n" +
+	"\x05state\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x05state\"\t\n" +
+	"\aJWKSReq\"\x1d\n" +
+	"\aJWKSRes\x12\x12\n" +
+	"\x04jwks\x18\x01 \x01(\tR\x04jwks2\xcc\x12\n" +
+	"\vUserService\x12'\n" +
+	"\aRegUser\x12\r.users.RegReq\x1a\r.users.RegRes\x12'\n" +
+	"\aLogUser\x12\r.users.LogReq\x1a\r.users.LogRes\x128\n" +
+	"\n" +
+	"ExtJWTData\x12\x14.users.ExtJWTDataReq\x1a\x14.users.ExtJWTDataRes\x12/\n" +
+	"\aDelUser\x12\x11.users.DelUserReq\x1a\x11.users.DelUserRes\x12;\n" +
+	"\vVerifyEmail\x12\x15.users.VerifyEmailReq\x1a\x15.users.VerifyEmailRes\x12P\n" +
+	"\x12ResendVerification\x12\x1c.users.ResendVerificationReq\x1a\x1c.users.ResendVerificationRes\x125\n" +
+	"\tVerifyMFA\x12\x13.users.VerifyMFAReq\x1a\x13.users.VerifyMFARes\x128\n" +
+	"\n" +
+	"EnrollTOTP\x12\x14.users.EnrollTOTPReq\x1a\x14.users.EnrollTOTPRes\x12;\n" +
+	"\vConfirmTOTP\x12\x15.users.ConfirmTOTPReq\x1a\x15.users.ConfirmTOTPRes\x128\n" +
+	"\n" +
+	"SetMFARole\x12\x14.users.SetMFARoleReq\x1a\x14.users.SetMFARoleRes\x128\n" +
+	"\n" +
+	"UnlockUser\x12\x14.users.UnlockUserReq\x1a\x14.users.UnlockUserRes\x12/\n" +
+	"\aGetUser\x12\x11.users.GetUserReq\x1a\x11.users.GetUserRes\x128\n" +
+	"\n" +
+	"UpdateUser\x12\x14.users.UpdateUserReq\x1a\x14.users.UpdateUserRes\x125\n" +
+	"\tListUsers\x12\x13.users.ListUsersReq\x1a\x13.users.ListUsersRes\x12;\n" +
+	"\vSetUserRole\x12\x15.users.SetUserRoleReq\x1a\x15.users.SetUserRoleRes\x12;\n" +
+	"\vImpersonate\x12\x15.users.ImpersonateReq\x1a\x15.users.ImpersonateRes\x12D\n" +
+	"\x0eExportUserData\x12\x18.users.ExportUserDataReq\x1a\x18.users.ExportUserDataRes\x125\n" +
+	"\tEraseUser\x12\x13.users.EraseUserReq\x1a\x13.users.EraseUserRes\x128\n" +
+	"\n" +
+	"GetErasure\x12\x14.users.GetErasureReq\x1a\x14.users.GetErasureRes\x12A\n" +
+	"\rListDeletions\x12\x17.users.ListDeletionsReq\x1a\x17.users.ListDeletionsRes\x12>\n" +
+	"\fCreateInvite\x12\x16.users.CreateInviteReq\x1a\x16.users.CreateInviteRes\x12;\n" +
+	"\vListInvites\x12\x15.users.ListInvitesReq\x1a\x15.users.ListInvitesRes\x12G\n" +
+	"\x0fListRedemptions\x12\x19.users.ListRedemptionsReq\x1a\x19.users.ListRedemptionsRes\x12>\n" +
+	"\fRevokeInvite\x12\x16.users.RevokeInviteReq\x1a\x16.users.RevokeInviteRes\x12>\n" +
+	"\fCreateAPIKey\x12\x16.users.CreateAPIKeyReq\x1a\x16.users.CreateAPIKeyRes\x12;\n" +
+	"\vListAPIKeys\x12\x15.users.ListAPIKeysReq\x1a\x15.users.ListAPIKeysRes\x12>\n" +
+	"\fRevokeAPIKey\x12\x16.users.RevokeAPIKeyReq\x1a\x16.users.RevokeAPIKeyRes\x128\n" +
+	"\n" +
+	"AuthAPIKey\x12\x14.users.AuthAPIKeyReq\x1a\x14.users.AuthAPIKeyRes\x12D\n" +
+	"\x0eRegisterClient\x12\x18.users.RegisterClientReq\x1a\x18.users.RegisterClientRes\x12;\n" +
+	"\vListClients\x12\x15.users.ListClientsReq\x1a\x15.users.ListClientsRes\x12>\n" +
+	"\fDeleteClient\x12\x16.users.DeleteClientReq\x1a\x16.users.DeleteClientRes\x125\n" +
+	"\tAuthorize\x12\x13.users.AuthorizeReq\x1a\x13.users.AuthorizeRes\x12)\n" +
+	"\x05Token\x12\x0f.users.TokenReq\x1a\x0f.users.TokenRes\x12G\n" +
+	"\x0fAuthAccessToken\x12\x19.users.AuthAccessTokenReq\x1a\x19.users.AuthAccessTokenRes\x12A\n" +
+	"\rOAuthUserInfo\x12\x17.users.OAuthUserInfoReq\x1a\x17.users.OAuthUserInfoRes\x12&\n" +
+	"\x04JWKS\x12\x0e.users.JWKSReq\x1a\x0e.users.JWKSRes\x12=\n" +
+	"\x0fIntrospectToken\x12\x14.users.IntrospectReq\x1a\x14.users.IntrospectRes\x12;\n" +
+	"\vRevokeToken\x12\x15.users.RevokeTokenReq\x1a\x15.users.RevokeTokenRes\x122\n" +
+	"\bSSOStart\x12\x12.users.SSOStartReq\x1a\x12.users.SSOStartRes\x123\n" +
+	"\vSSOCallback\x12\x15.users.SSOCallbackReq\x1a\r.users.LogResB\x10Z\x0e./;userserviceb\x06proto3"
 
 var (
 	file_user_service_proto_rawDescOnce sync.Once
@@ -528,31 +5826,212 @@ func file_user_service_proto_rawDescGZIP() []byte {
 	return file_user_service_proto_rawDescData
 }
 
-var file_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 87)
 var file_user_service_proto_goTypes = []any{
-	(*RegReq)(nil),        // 0: users.RegReq
-	(*RegRes)(nil),        // 1: users.RegRes
-	(*LogReq)(nil),        // 2: users.LogReq
-	(*LogRes)(nil),        // 3: users.LogRes
-	(*ExtJWTDataReq)(nil), // 4: users.ExtJWTDataReq
-	(*ExtJWTDataRes)(nil), // 5: users.ExtJWTDataRes
-	(*DelUserReq)(nil),    // 6: users.DelUserReq
-	(*DelUserRes)(nil),    // 7: users.DelUserRes
+	(*RegReq)(nil),                // 0: users.RegReq
+	(*RegRes)(nil),                // 1: users.RegRes
+	(*LogReq)(nil),                // 2: users.LogReq
+	(*LogRes)(nil),                // 3: users.LogRes
+	(*VerifyMFAReq)(nil),          // 4: users.VerifyMFAReq
+	(*VerifyMFARes)(nil),          // 5: users.VerifyMFARes
+	(*ExtJWTDataReq)(nil),         // 6: users.ExtJWTDataReq
+	(*ExtJWTDataRes)(nil),         // 7: users.ExtJWTDataRes
+	(*DelUserReq)(nil),            // 8: users.DelUserReq
+	(*DelUserRes)(nil),            // 9: users.DelUserRes
+	(*VerifyEmailReq)(nil),        // 10: users.VerifyEmailReq
+	(*VerifyEmailRes)(nil),        // 11: users.VerifyEmailRes
+	(*ResendVerificationReq)(nil), // 12: users.ResendVerificationReq
+	(*ResendVerificationRes)(nil), // 13: users.ResendVerificationRes
+	(*EnrollTOTPReq)(nil),         // 14: users.EnrollTOTPReq
+	(*EnrollTOTPRes)(nil),         // 15: users.EnrollTOTPRes
+	(*ConfirmTOTPReq)(nil),        // 16: users.ConfirmTOTPReq
+	(*ConfirmTOTPRes)(nil),        // 17: users.ConfirmTOTPRes
+	(*SetMFARoleReq)(nil),         // 18: users.SetMFARoleReq
+	(*SetMFARoleRes)(nil),         // 19: users.SetMFARoleRes
+	(*UnlockUserReq)(nil),         // 20: users.UnlockUserReq
+	(*UnlockUserRes)(nil),         // 21: users.UnlockUserRes
+	(*UserProfile)(nil),           // 22: users.UserProfile
+	(*GetUserReq)(nil),            // 23: users.GetUserReq
+	(*GetUserRes)(nil),            // 24: users.GetUserRes
+	(*UpdateUserReq)(nil),         // 25: users.UpdateUserReq
+	(*UpdateUserRes)(nil),         // 26: users.UpdateUserRes
+	(*ListUsersReq)(nil),          // 27: users.ListUsersReq
+	(*ListUsersRes)(nil),          // 28: users.ListUsersRes
+	(*SetUserRoleReq)(nil),        // 29: users.SetUserRoleReq
+	(*SetUserRoleRes)(nil),        // 30: users.SetUserRoleRes
+	(*ImpersonateReq)(nil),        // 31: users.ImpersonateReq
+	(*ImpersonateRes)(nil),        // 32: users.ImpersonateRes
+	(*Deletion)(nil),              // 33: users.Deletion
+	(*ListDeletionsReq)(nil),      // 34: users.ListDeletionsReq
+	(*ListDeletionsRes)(nil),      // 35: users.ListDeletionsRes
+	(*Invite)(nil),                // 36: users.Invite
+	(*CreateInviteReq)(nil),       // 37: users.CreateInviteReq
+	(*CreateInviteRes)(nil),       // 38: users.CreateInviteRes
+	(*ListInvitesReq)(nil),        // 39: users.ListInvitesReq
+	(*ListInvitesRes)(nil),        // 40: users.ListInvitesRes
+	(*Redemption)(nil),            // 41: users.Redemption
+	(*ListRedemptionsReq)(nil),    // 42: users.ListRedemptionsReq
+	(*ListRedemptionsRes)(nil),    // 43: users.ListRedemptionsRes
+	(*RevokeInviteReq)(nil),       // 44: users.RevokeInviteReq
+	(*RevokeInviteRes)(nil),       // 45: users.RevokeInviteRes
+	(*ExportUserDataReq)(nil),     // 46: users.ExportUserDataReq
+	(*ExportUserDataRes)(nil),     // 47: users.ExportUserDataRes
+	(*ErasureRecord)(nil),         // 48: users.ErasureRecord
+	(*EraseUserReq)(nil),          // 49: users.EraseUserReq
+	(*EraseUserRes)(nil),          // 50: users.EraseUserRes
+	(*GetErasureReq)(nil),         // 51: users.GetErasureReq
+	(*GetErasureRes)(nil),         // 52: users.GetErasureRes
+	(*APIKey)(nil),                // 53: users.APIKey
+	(*CreateAPIKeyReq)(nil),       // 54: users.CreateAPIKeyReq
+	(*CreateAPIKeyRes)(nil),       // 55: users.CreateAPIKeyRes
+	(*ListAPIKeysReq)(nil),        // 56: users.ListAPIKeysReq
+	(*ListAPIKeysRes)(nil),        // 57: users.ListAPIKeysRes
+	(*RevokeAPIKeyReq)(nil),       // 58: users.RevokeAPIKeyReq
+	(*RevokeAPIKeyRes)(nil),       // 59: users.RevokeAPIKeyRes
+	(*AuthAPIKeyReq)(nil),         // 60: users.AuthAPIKeyReq
+	(*AuthAPIKeyRes)(nil),         // 61: users.AuthAPIKeyRes
+	(*OAuthClient)(nil),           // 62: users.OAuthClient
+	(*RegisterClientReq)(nil),     // 63: users.RegisterClientReq
+	(*RegisterClientRes)(nil),     // 64: users.RegisterClientRes
+	(*ListClientsReq)(nil),        // 65: users.ListClientsReq
+	(*ListClientsRes)(nil),        // 66: users.ListClientsRes
+	(*DeleteClientReq)(nil),       // 67: users.DeleteClientReq
+	(*DeleteClientRes)(nil),       // 68: users.DeleteClientRes
+	(*AuthorizeReq)(nil),          // 69: users.AuthorizeReq
+	(*AuthorizeRes)(nil),          // 70: users.AuthorizeRes
+	(*TokenReq)(nil),              // 71: users.TokenReq
+	(*TokenRes)(nil),              // 72: users.TokenRes
+	(*AuthAccessTokenReq)(nil),    // 73: users.AuthAccessTokenReq
+	(*AuthAccessTokenRes)(nil),    // 74: users.AuthAccessTokenRes
+	(*OAuthUserInfoReq)(nil),      // 75: users.OAuthUserInfoReq
+	(*OAuthUserInfoRes)(nil),      // 76: users.OAuthUserInfoRes
+	(*IntrospectReq)(nil),         // 77: users.IntrospectReq
+	(*IntrospectRes)(nil),         // 78: users.IntrospectRes
+	(*RevokeTokenReq)(nil),        // 79: users.RevokeTokenReq
+	(*RevokeTokenRes)(nil),        // 80: users.RevokeTokenRes
+	(*SSOStartReq)(nil),           // 81: users.SSOStartReq
+	(*SSOStartRes)(nil),           // 82: users.SSOStartRes
+	(*SSOCallbackReq)(nil),        // 83: users.SSOCallbackReq
+	(*JWKSReq)(nil),               // 84: users.JWKSReq
+	(*JWKSRes)(nil),               // 85: users.JWKSRes
+	nil,                           // 86: users.ErasureRecord.StepsEntry
+	(*timestamppb.Timestamp)(nil), // 87: google.protobuf.Timestamp
 }
 var file_user_service_proto_depIdxs = []int32{
-	0, // 0: users.UserService.RegUser:input_type -> users.RegReq
-	2, // 1: users.UserService.LogUser:input_type -> users.LogReq
-	4, // 2: users.UserService.ExtJWTData:input_type -> users.ExtJWTDataReq
-	6, // 3: users.UserService.DelUser:input_type -> users.DelUserReq
-	1, // 4: users.UserService.RegUser:output_type -> users.RegRes
-	3, // 5: users.UserService.LogUser:output_type -> users.LogRes
-	5, // 6: users.UserService.ExtJWTData:output_type -> users.ExtJWTDataRes
-	7, // 7: users.UserService.DelUser:output_type -> users.DelUserRes
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	87, // 0: users.UserProfile.created_at:type_name -> google.protobuf.Timestamp
+	87, // 1: users.UserProfile.updated_at:type_name -> google.protobuf.Timestamp
+	22, // 2: users.GetUserRes.user:type_name -> users.UserProfile
+	22, // 3: users.UpdateUserRes.user:type_name -> users.UserProfile
+	87, // 4: users.ListUsersReq.created_from:type_name -> google.protobuf.Timestamp
+	87, // 5: users.ListUsersReq.created_to:type_name -> google.protobuf.Timestamp
+	22, // 6: users.ListUsersRes.users:type_name -> users.UserProfile
+	87, // 7: users.Deletion.next_attempt_at:type_name -> google.protobuf.Timestamp
+	87, // 8: users.Deletion.created_at:type_name -> google.protobuf.Timestamp
+	87, // 9: users.Deletion.updated_at:type_name -> google.protobuf.Timestamp
+	33, // 10: users.ListDeletionsRes.deletions:type_name -> users.Deletion
+	87, // 11: users.Invite.expires_at:type_name -> google.protobuf.Timestamp
+	87, // 12: users.Invite.created_at:type_name -> google.protobuf.Timestamp
+	87, // 13: users.Invite.revoked_at:type_name -> google.protobuf.Timestamp
+	36, // 14: users.CreateInviteRes.invite:type_name -> users.Invite
+	36, // 15: users.ListInvitesRes.invites:type_name -> users.Invite
+	87, // 16: users.Redemption.redeemed_at:type_name -> google.protobuf.Timestamp
+	41, // 17: users.ListRedemptionsRes.redemptions:type_name -> users.Redemption
+	86, // 18: users.ErasureRecord.steps:type_name -> users.ErasureRecord.StepsEntry
+	87, // 19: users.ErasureRecord.completed_at:type_name -> google.protobuf.Timestamp
+	48, // 20: users.EraseUserRes.record:type_name -> users.ErasureRecord
+	48, // 21: users.GetErasureRes.record:type_name -> users.ErasureRecord
+	87, // 22: users.APIKey.created_at:type_name -> google.protobuf.Timestamp
+	87, // 23: users.APIKey.last_used_at:type_name -> google.protobuf.Timestamp
+	53, // 24: users.CreateAPIKeyRes.key:type_name -> users.APIKey
+	53, // 25: users.ListAPIKeysRes.keys:type_name -> users.APIKey
+	87, // 26: users.OAuthClient.created_at:type_name -> google.protobuf.Timestamp
+	62, // 27: users.RegisterClientRes.client:type_name -> users.OAuthClient
+	62, // 28: users.ListClientsRes.clients:type_name -> users.OAuthClient
+	0,  // 29: users.UserService.RegUser:input_type -> users.RegReq
+	2,  // 30: users.UserService.LogUser:input_type -> users.LogReq
+	6,  // 31: users.UserService.ExtJWTData:input_type -> users.ExtJWTDataReq
+	8,  // 32: users.UserService.DelUser:input_type -> users.DelUserReq
+	10, // 33: users.UserService.VerifyEmail:input_type -> users.VerifyEmailReq
+	12, // 34: users.UserService.ResendVerification:input_type -> users.ResendVerificationReq
+	4,  // 35: users.UserService.VerifyMFA:input_type -> users.VerifyMFAReq
+	14, // 36: users.UserService.EnrollTOTP:input_type -> users.EnrollTOTPReq
+	16, // 37: users.UserService.ConfirmTOTP:input_type -> users.ConfirmTOTPReq
+	18, // 38: users.UserService.SetMFARole:input_type -> users.SetMFARoleReq
+	20, // 39: users.UserService.UnlockUser:input_type -> users.UnlockUserReq
+	23, // 40: users.UserService.GetUser:input_type -> users.GetUserReq
+	25, // 41: users.UserService.UpdateUser:input_type -> users.UpdateUserReq
+	27, // 42: users.UserService.ListUsers:input_type -> users.ListUsersReq
+	29, // 43: users.UserService.SetUserRole:input_type -> users.SetUserRoleReq
+	31, // 44: users.UserService.Impersonate:input_type -> users.ImpersonateReq
+	46, // 45: users.UserService.ExportUserData:input_type -> users.ExportUserDataReq
+	49, // 46: users.UserService.EraseUser:input_type -> users.EraseUserReq
+	51, // 47: users.UserService.GetErasure:input_type -> users.GetErasureReq
+	34, // 48: users.UserService.ListDeletions:input_type -> users.ListDeletionsReq
+	37, // 49: users.UserService.CreateInvite:input_type -> users.CreateInviteReq
+	39, // 50: users.UserService.ListInvites:input_type -> users.ListInvitesReq
+	42, // 51: users.UserService.ListRedemptions:input_type -> users.ListRedemptionsReq
+	44, // 52: users.UserService.RevokeInvite:input_type -> users.RevokeInviteReq
+	54, // 53: users.UserService.CreateAPIKey:input_type -> users.CreateAPIKeyReq
+	56, // 54: users.UserService.ListAPIKeys:input_type -> users.ListAPIKeysReq
+	58, // 55: users.UserService.RevokeAPIKey:input_type -> users.RevokeAPIKeyReq
+	60, // 56: users.UserService.AuthAPIKey:input_type -> users.AuthAPIKeyReq
+	63, // 57: users.UserService.RegisterClient:input_type -> users.RegisterClientReq
+	65, // 58: users.UserService.ListClients:input_type -> users.ListClientsReq
+	67, // 59: users.UserService.DeleteClient:input_type -> users.DeleteClientReq
+	69, // 60: users.UserService.Authorize:input_type -> users.AuthorizeReq
+	71, // 61: users.UserService.Token:input_type -> users.TokenReq
+	73, // 62: users.UserService.AuthAccessToken:input_type -> users.AuthAccessTokenReq
+	75, // 63: users.UserService.OAuthUserInfo:input_type -> users.OAuthUserInfoReq
+	84, // 64: users.UserService.JWKS:input_type -> users.JWKSReq
+	77, // 65: users.UserService.IntrospectToken:input_type -> users.IntrospectReq
+	79, // 66: users.UserService.RevokeToken:input_type -> users.RevokeTokenReq
+	81, // 67: users.UserService.SSOStart:input_type -> users.SSOStartReq
+	83, // 68: users.UserService.SSOCallback:input_type -> users.SSOCallbackReq
+	1,  // 69: users.UserService.RegUser:output_type -> users.RegRes
+	3,  // 70: users.UserService.LogUser:output_type -> users.LogRes
+	7,  // 71: users.UserService.ExtJWTData:output_type -> users.ExtJWTDataRes
+	9,  // 72: users.UserService.DelUser:output_type -> users.DelUserRes
+	11, // 73: users.UserService.VerifyEmail:output_type -> users.VerifyEmailRes
+	13, // 74: users.UserService.ResendVerification:output_type -> users.ResendVerificationRes
+	5,  // 75: users.UserService.VerifyMFA:output_type -> users.VerifyMFARes
+	15, // 76: users.UserService.EnrollTOTP:output_type -> users.EnrollTOTPRes
+	17, // 77: users.UserService.ConfirmTOTP:output_type -> users.ConfirmTOTPRes
+	19, // 78: users.UserService.SetMFARole:output_type -> users.SetMFARoleRes
+	21, // 79: users.UserService.UnlockUser:output_type -> users.UnlockUserRes
+	24, // 80: users.UserService.GetUser:output_type -> users.GetUserRes
+	26, // 81: users.UserService.UpdateUser:output_type -> users.UpdateUserRes
+	28, // 82: users.UserService.ListUsers:output_type -> users.ListUsersRes
+	30, // 83: users.UserService.SetUserRole:output_type -> users.SetUserRoleRes
+	32, // 84: users.UserService.Impersonate:output_type -> users.ImpersonateRes
+	47, // 85: users.UserService.ExportUserData:output_type -> users.ExportUserDataRes
+	50, // 86: users.UserService.EraseUser:output_type -> users.EraseUserRes
+	52, // 87: users.UserService.GetErasure:output_type -> users.GetErasureRes
+	35, // 88: users.UserService.ListDeletions:output_type -> users.ListDeletionsRes
+	38, // 89: users.UserService.CreateInvite:output_type -> users.CreateInviteRes
+	40, // 90: users.UserService.ListInvites:output_type -> users.ListInvitesRes
+	43, // 91: users.UserService.ListRedemptions:output_type -> users.ListRedemptionsRes
+	45, // 92: users.UserService.RevokeInvite:output_type -> users.RevokeInviteRes
+	55, // 93: users.UserService.CreateAPIKey:output_type -> users.CreateAPIKeyRes
+	57, // 94: users.UserService.ListAPIKeys:output_type -> users.ListAPIKeysRes
+	59, // 95: users.UserService.RevokeAPIKey:output_type -> users.RevokeAPIKeyRes
+	61, // 96: users.UserService.AuthAPIKey:output_type -> users.AuthAPIKeyRes
+	64, // 97: users.UserService.RegisterClient:output_type -> users.RegisterClientRes
+	66, // 98: users.UserService.ListClients:output_type -> users.ListClientsRes
+	68, // 99: users.UserService.DeleteClient:output_type -> users.DeleteClientRes
+	70, // 100: users.UserService.Authorize:output_type -> users.AuthorizeRes
+	72, // 101: users.UserService.Token:output_type -> users.TokenRes
+	74, // 102: users.UserService.AuthAccessToken:output_type -> users.AuthAccessTokenRes
+	76, // 103: users.UserService.OAuthUserInfo:output_type -> users.OAuthUserInfoRes
+	85, // 104: users.UserService.JWKS:output_type -> users.JWKSRes
+	78, // 105: users.UserService.IntrospectToken:output_type -> users.IntrospectRes
+	80, // 106: users.UserService.RevokeToken:output_type -> users.RevokeTokenRes
+	82, // 107: users.UserService.SSOStart:output_type -> users.SSOStartRes
+	3,  // 108: users.UserService.SSOCallback:output_type -> users.LogRes
+	69, // [69:109] is the sub-list for method output_type
+	29, // [29:69] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_user_service_proto_init() }
@@ -560,13 +6039,14 @@ func file_user_service_proto_init() {
 	if File_user_service_proto != nil {
 		return
 	}
+	file_user_service_proto_msgTypes[25].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_service_proto_rawDesc), len(file_user_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   87,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -82,10 +82,12 @@ func (m *RegReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _RegReq_Role_InLookup[m.GetRole()]; !ok {
+	// no validation rules for Role
+
+	if utf8.RuneCountInString(m.GetPassword()) < 8 {
 		err := RegReqValidationError{
-			field:  "Role",
-			reason: "value must be in list [admin dev guest]",
+			field:  "Password",
+			reason: "value length must be at least 8 runes",
 		}
 		if !all {
 			return err
@@ -93,10 +95,10 @@ func (m *RegReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetPassword()) < 8 {
+	if utf8.RuneCountInString(m.GetInviteCode()) > 64 {
 		err := RegReqValidationError{
-			field:  "Password",
-			reason: "value length must be at least 8 runes",
+			field:  "InviteCode",
+			reason: "value length must be at most 64 runes",
 		}
 		if !all {
 			return err
@@ -231,12 +233,6 @@ var _ interface {
 	ErrorName() string
 } = RegReqValidationError{}
 
-var _RegReq_Role_InLookup = map[string]struct{}{
-	"admin": {},
-	"dev":   {},
-	"guest": {},
-}
-
 // Validate checks the field values on RegRes with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -387,27 +383,35 @@ func (m *LogReq) validate(all bool) error {
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetName()); l < 2 || l > 50 {
-		err := LogReqValidationError{
-			field:  "Name",
-			reason: "value length must be between 2 and 50 runes, inclusive",
-		}
-		if !all {
-			return err
+	if m.GetName() != "" {
+
+		if l := utf8.RuneCountInString(m.GetName()); l < 2 || l > 50 {
+			err := LogReqValidationError{
+				field:  "Name",
+				reason: "value length must be between 2 and 50 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
 	}
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = LogReqValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
+	if m.GetEmail() != "" {
+
+		if err := m._validateEmail(m.GetEmail()); err != nil {
+			err = LogReqValidationError{
+				field:  "Email",
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
 	}
 
 	if utf8.RuneCountInString(m.GetPassword()) < 8 {
@@ -421,6 +425,21 @@ func (m *LogReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetClientIp() != "" {
+
+		if ip := net.ParseIP(m.GetClientIp()); ip == nil {
+			err := LogReqValidationError{
+				field:  "ClientIp",
+				reason: "value must be a valid IP address",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return LogReqMultiError(errors)
 	}
@@ -592,6 +611,12 @@ func (m *LogRes) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for MfaRequired
+
+	// no validation rules for MfaToken
+
+	// no validation rules for MfaEnrolRequired
+
 	if len(errors) > 0 {
 		return LogResMultiError(errors)
 	}
@@ -677,6 +702,266 @@ var _ interface {
 	ErrorName() string
 } = LogResValidationError{}
 
+// Validate checks the field values on VerifyMFAReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VerifyMFAReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyMFAReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VerifyMFAReqMultiError, or
+// nil if none found.
+func (m *VerifyMFAReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyMFAReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetMfaToken()); err != nil {
+		err = VerifyMFAReqValidationError{
+			field:  "MfaToken",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCode()); l < 6 || l > 16 {
+		err := VerifyMFAReqValidationError{
+			field:  "Code",
+			reason: "value length must be between 6 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return VerifyMFAReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *VerifyMFAReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// VerifyMFAReqMultiError is an error wrapping multiple validation errors
+// returned by VerifyMFAReq.ValidateAll() if the designated constraints aren't met.
+type VerifyMFAReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyMFAReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyMFAReqMultiError) AllErrors() []error { return m }
+
+// VerifyMFAReqValidationError is the validation error returned by
+// VerifyMFAReq.Validate if the designated constraints aren't met.
+type VerifyMFAReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyMFAReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyMFAReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyMFAReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyMFAReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyMFAReqValidationError) ErrorName() string { return "VerifyMFAReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VerifyMFAReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyMFAReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyMFAReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyMFAReqValidationError{}
+
+// Validate checks the field values on VerifyMFARes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VerifyMFARes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyMFARes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VerifyMFAResMultiError, or
+// nil if none found.
+func (m *VerifyMFARes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyMFARes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 100 {
+		err := VerifyMFAResValidationError{
+			field:  "Token",
+			reason: "value length must be at least 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetSessionKey()); err != nil {
+		err = VerifyMFAResValidationError{
+			field:  "SessionKey",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return VerifyMFAResMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *VerifyMFARes) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// VerifyMFAResMultiError is an error wrapping multiple validation errors
+// returned by VerifyMFARes.ValidateAll() if the designated constraints aren't met.
+type VerifyMFAResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyMFAResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyMFAResMultiError) AllErrors() []error { return m }
+
+// VerifyMFAResValidationError is the validation error returned by
+// VerifyMFARes.Validate if the designated constraints aren't met.
+type VerifyMFAResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyMFAResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyMFAResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyMFAResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyMFAResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyMFAResValidationError) ErrorName() string { return "VerifyMFAResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VerifyMFAResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyMFARes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyMFAResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyMFAResValidationError{}
+
 // Validate checks the field values on ExtJWTDataReq with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -830,10 +1115,10 @@ func (m *ExtJWTDataRes) validate(all bool) error {
 
 	var errors []error
 
-	if _, ok := _ExtJWTDataRes_Role_InLookup[m.GetRole()]; !ok {
+	if l := utf8.RuneCountInString(m.GetRole()); l < 1 || l > 50 {
 		err := ExtJWTDataResValidationError{
 			field:  "Role",
-			reason: "value must be in list [admin dev guest]",
+			reason: "value length must be between 1 and 50 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -854,6 +1139,10 @@ func (m *ExtJWTDataRes) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for EmailVerified
+
+	// no validation rules for ActorId
+
 	if len(errors) > 0 {
 		return ExtJWTDataResMultiError(errors)
 	}
@@ -932,12 +1221,6 @@ var _ interface {
 	ErrorName() string
 } = ExtJWTDataResValidationError{}
 
-var _ExtJWTDataRes_Role_InLookup = map[string]struct{}{
-	"admin": {},
-	"dev":   {},
-	"guest": {},
-}
-
 // Validate checks the field values on DelUserReq with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -960,10 +1243,10 @@ func (m *DelUserReq) validate(all bool) error {
 
 	var errors []error
 
-	if _, ok := _DelUserReq_Role_InLookup[m.GetRole()]; !ok {
+	if l := utf8.RuneCountInString(m.GetRole()); l < 1 || l > 50 {
 		err := DelUserReqValidationError{
 			field:  "Role",
-			reason: "value must be in list [admin dev guest]",
+			reason: "value length must be between 1 and 50 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -1082,12 +1365,6 @@ var _ interface {
 	ErrorName() string
 } = DelUserReqValidationError{}
 
-var _DelUserReq_Role_InLookup = map[string]struct{}{
-	"admin": {},
-	"dev":   {},
-	"guest": {},
-}
-
 // Validate checks the field values on DelUserRes with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -1110,6 +1387,8 @@ func (m *DelUserRes) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for DeletionId
+
 	if len(errors) > 0 {
 		return DelUserResMultiError(errors)
 	}
@@ -1186,3 +1465,10201 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = DelUserResValidationError{}
+
+// Validate checks the field values on VerifyEmailReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VerifyEmailReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyEmailReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VerifyEmailReqMultiError,
+// or nil if none found.
+func (m *VerifyEmailReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyEmailReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetToken()); err != nil {
+		err = VerifyEmailReqValidationError{
+			field:  "Token",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return VerifyEmailReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *VerifyEmailReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// VerifyEmailReqMultiError is an error wrapping multiple validation errors
+// returned by VerifyEmailReq.ValidateAll() if the designated constraints
+// aren't met.
+type VerifyEmailReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyEmailReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyEmailReqMultiError) AllErrors() []error { return m }
+
+// VerifyEmailReqValidationError is the validation error returned by
+// VerifyEmailReq.Validate if the designated constraints aren't met.
+type VerifyEmailReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyEmailReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyEmailReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyEmailReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyEmailReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyEmailReqValidationError) ErrorName() string { return "VerifyEmailReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VerifyEmailReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyEmailReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyEmailReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyEmailReqValidationError{}
+
+// Validate checks the field values on VerifyEmailRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VerifyEmailRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyEmailRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VerifyEmailResMultiError,
+// or nil if none found.
+func (m *VerifyEmailRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyEmailRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return VerifyEmailResMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyEmailResMultiError is an error wrapping multiple validation errors
+// returned by VerifyEmailRes.ValidateAll() if the designated constraints
+// aren't met.
+type VerifyEmailResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyEmailResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyEmailResMultiError) AllErrors() []error { return m }
+
+// VerifyEmailResValidationError is the validation error returned by
+// VerifyEmailRes.Validate if the designated constraints aren't met.
+type VerifyEmailResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyEmailResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyEmailResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyEmailResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyEmailResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyEmailResValidationError) ErrorName() string { return "VerifyEmailResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VerifyEmailResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyEmailRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyEmailResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyEmailResValidationError{}
+
+// Validate checks the field values on ResendVerificationReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResendVerificationReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResendVerificationReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResendVerificationReqMultiError, or nil if none found.
+func (m *ResendVerificationReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResendVerificationReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ResendVerificationReqValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResendVerificationReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ResendVerificationReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ResendVerificationReqMultiError is an error wrapping multiple validation
+// errors returned by ResendVerificationReq.ValidateAll() if the designated
+// constraints aren't met.
+type ResendVerificationReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResendVerificationReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResendVerificationReqMultiError) AllErrors() []error { return m }
+
+// ResendVerificationReqValidationError is the validation error returned by
+// ResendVerificationReq.Validate if the designated constraints aren't met.
+type ResendVerificationReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResendVerificationReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResendVerificationReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResendVerificationReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResendVerificationReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResendVerificationReqValidationError) ErrorName() string {
+	return "ResendVerificationReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResendVerificationReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResendVerificationReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResendVerificationReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResendVerificationReqValidationError{}
+
+// Validate checks the field values on ResendVerificationRes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResendVerificationRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResendVerificationRes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResendVerificationResMultiError, or nil if none found.
+func (m *ResendVerificationRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResendVerificationRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ResendVerificationResMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResendVerificationResMultiError is an error wrapping multiple validation
+// errors returned by ResendVerificationRes.ValidateAll() if the designated
+// constraints aren't met.
+type ResendVerificationResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResendVerificationResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResendVerificationResMultiError) AllErrors() []error { return m }
+
+// ResendVerificationResValidationError is the validation error returned by
+// ResendVerificationRes.Validate if the designated constraints aren't met.
+type ResendVerificationResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResendVerificationResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResendVerificationResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResendVerificationResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResendVerificationResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResendVerificationResValidationError) ErrorName() string {
+	return "ResendVerificationResValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResendVerificationResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResendVerificationRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResendVerificationResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResendVerificationResValidationError{}
+
+// Validate checks the field values on EnrollTOTPReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EnrollTOTPReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrollTOTPReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EnrollTOTPReqMultiError, or
+// nil if none found.
+func (m *EnrollTOTPReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrollTOTPReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() != "" {
+
+		if err := m._validateUuid(m.GetUserId()); err != nil {
+			err = EnrollTOTPReqValidationError{
+				field:  "UserId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetMfaToken() != "" {
+
+		if err := m._validateUuid(m.GetMfaToken()); err != nil {
+			err = EnrollTOTPReqValidationError{
+				field:  "MfaToken",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return EnrollTOTPReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *EnrollTOTPReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// EnrollTOTPReqMultiError is an error wrapping multiple validation errors
+// returned by EnrollTOTPReq.ValidateAll() if the designated constraints
+// aren't met.
+type EnrollTOTPReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrollTOTPReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrollTOTPReqMultiError) AllErrors() []error { return m }
+
+// EnrollTOTPReqValidationError is the validation error returned by
+// EnrollTOTPReq.Validate if the designated constraints aren't met.
+type EnrollTOTPReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollTOTPReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollTOTPReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollTOTPReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollTOTPReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollTOTPReqValidationError) ErrorName() string { return "EnrollTOTPReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EnrollTOTPReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollTOTPReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollTOTPReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollTOTPReqValidationError{}
+
+// Validate checks the field values on EnrollTOTPRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EnrollTOTPRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrollTOTPRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EnrollTOTPResMultiError, or
+// nil if none found.
+func (m *EnrollTOTPRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrollTOTPRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Secret
+
+	// no validation rules for OtpauthUri
+
+	if len(errors) > 0 {
+		return EnrollTOTPResMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrollTOTPResMultiError is an error wrapping multiple validation errors
+// returned by EnrollTOTPRes.ValidateAll() if the designated constraints
+// aren't met.
+type EnrollTOTPResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrollTOTPResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrollTOTPResMultiError) AllErrors() []error { return m }
+
+// EnrollTOTPResValidationError is the validation error returned by
+// EnrollTOTPRes.Validate if the designated constraints aren't met.
+type EnrollTOTPResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollTOTPResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollTOTPResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollTOTPResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollTOTPResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollTOTPResValidationError) ErrorName() string { return "EnrollTOTPResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EnrollTOTPResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollTOTPRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollTOTPResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollTOTPResValidationError{}
+
+// Validate checks the field values on ConfirmTOTPReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConfirmTOTPReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmTOTPReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConfirmTOTPReqMultiError,
+// or nil if none found.
+func (m *ConfirmTOTPReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmTOTPReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() != "" {
+
+		if err := m._validateUuid(m.GetUserId()); err != nil {
+			err = ConfirmTOTPReqValidationError{
+				field:  "UserId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetMfaToken() != "" {
+
+		if err := m._validateUuid(m.GetMfaToken()); err != nil {
+			err = ConfirmTOTPReqValidationError{
+				field:  "MfaToken",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetCode()) != 6 {
+		err := ConfirmTOTPReqValidationError{
+			field:  "Code",
+			reason: "value length must be 6 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if len(errors) > 0 {
+		return ConfirmTOTPReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ConfirmTOTPReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ConfirmTOTPReqMultiError is an error wrapping multiple validation errors
+// returned by ConfirmTOTPReq.ValidateAll() if the designated constraints
+// aren't met.
+type ConfirmTOTPReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmTOTPReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmTOTPReqMultiError) AllErrors() []error { return m }
+
+// ConfirmTOTPReqValidationError is the validation error returned by
+// ConfirmTOTPReq.Validate if the designated constraints aren't met.
+type ConfirmTOTPReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmTOTPReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmTOTPReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmTOTPReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmTOTPReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmTOTPReqValidationError) ErrorName() string { return "ConfirmTOTPReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConfirmTOTPReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmTOTPReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmTOTPReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmTOTPReqValidationError{}
+
+// Validate checks the field values on ConfirmTOTPRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConfirmTOTPRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmTOTPRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConfirmTOTPResMultiError,
+// or nil if none found.
+func (m *ConfirmTOTPRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmTOTPRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for SessionKey
+
+	if len(errors) > 0 {
+		return ConfirmTOTPResMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmTOTPResMultiError is an error wrapping multiple validation errors
+// returned by ConfirmTOTPRes.ValidateAll() if the designated constraints
+// aren't met.
+type ConfirmTOTPResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmTOTPResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmTOTPResMultiError) AllErrors() []error { return m }
+
+// ConfirmTOTPResValidationError is the validation error returned by
+// ConfirmTOTPRes.Validate if the designated constraints aren't met.
+type ConfirmTOTPResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmTOTPResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmTOTPResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmTOTPResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmTOTPResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmTOTPResValidationError) ErrorName() string { return "ConfirmTOTPResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConfirmTOTPResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmTOTPRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmTOTPResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmTOTPResValidationError{}
+
+// Validate checks the field values on SetMFARoleReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SetMFARoleReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetMFARoleReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SetMFARoleReqMultiError, or
+// nil if none found.
+func (m *SetMFARoleReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetMFARoleReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetRole()); l < 1 || l > 50 {
+		err := SetMFARoleReqValidationError{
+			field:  "Role",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetTargetRole()); l < 1 || l > 50 {
+		err := SetMFARoleReqValidationError{
+			field:  "TargetRole",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Required
+
+	if len(errors) > 0 {
+		return SetMFARoleReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetMFARoleReqMultiError is an error wrapping multiple validation errors
+// returned by SetMFARoleReq.ValidateAll() if the designated constraints
+// aren't met.
+type SetMFARoleReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetMFARoleReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetMFARoleReqMultiError) AllErrors() []error { return m }
+
+// SetMFARoleReqValidationError is the validation error returned by
+// SetMFARoleReq.Validate if the designated constraints aren't met.
+type SetMFARoleReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetMFARoleReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetMFARoleReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetMFARoleReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetMFARoleReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetMFARoleReqValidationError) ErrorName() string { return "SetMFARoleReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SetMFARoleReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetMFARoleReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetMFARoleReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetMFARoleReqValidationError{}
+
+// Validate checks the field values on SetMFARoleRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SetMFARoleRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetMFARoleRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SetMFARoleResMultiError, or
+// nil if none found.
+func (m *SetMFARoleRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetMFARoleRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetMFARoleResMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetMFARoleResMultiError is an error wrapping multiple validation errors
+// returned by SetMFARoleRes.ValidateAll() if the designated constraints
+// aren't met.
+type SetMFARoleResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetMFARoleResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetMFARoleResMultiError) AllErrors() []error { return m }
+
+// SetMFARoleResValidationError is the validation error returned by
+// SetMFARoleRes.Validate if the designated constraints aren't met.
+type SetMFARoleResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetMFARoleResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetMFARoleResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetMFARoleResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetMFARoleResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetMFARoleResValidationError) ErrorName() string { return "SetMFARoleResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SetMFARoleResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetMFARoleRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetMFARoleResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetMFARoleResValidationError{}
+
+// Validate checks the field values on UnlockUserReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UnlockUserReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlockUserReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UnlockUserReqMultiError, or
+// nil if none found.
+func (m *UnlockUserReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlockUserReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetRole()); l < 1 || l > 50 {
+		err := UnlockUserReqValidationError{
+			field:  "Role",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = UnlockUserReqValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnlockUserReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UnlockUserReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UnlockUserReqMultiError is an error wrapping multiple validation errors
+// returned by UnlockUserReq.ValidateAll() if the designated constraints
+// aren't met.
+type UnlockUserReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlockUserReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlockUserReqMultiError) AllErrors() []error { return m }
+
+// UnlockUserReqValidationError is the validation error returned by
+// UnlockUserReq.Validate if the designated constraints aren't met.
+type UnlockUserReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlockUserReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlockUserReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlockUserReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlockUserReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlockUserReqValidationError) ErrorName() string { return "UnlockUserReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnlockUserReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlockUserReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlockUserReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlockUserReqValidationError{}
+
+// Validate checks the field values on UnlockUserRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UnlockUserRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlockUserRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UnlockUserResMultiError, or
+// nil if none found.
+func (m *UnlockUserRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlockUserRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnlockUserResMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnlockUserResMultiError is an error wrapping multiple validation errors
+// returned by UnlockUserRes.ValidateAll() if the designated constraints
+// aren't met.
+type UnlockUserResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlockUserResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlockUserResMultiError) AllErrors() []error { return m }
+
+// UnlockUserResValidationError is the validation error returned by
+// UnlockUserRes.Validate if the designated constraints aren't met.
+type UnlockUserResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlockUserResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlockUserResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlockUserResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlockUserResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlockUserResValidationError) ErrorName() string { return "UnlockUserResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnlockUserResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlockUserRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlockUserResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlockUserResValidationError{}
+
+// Validate checks the field values on UserProfile with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserProfile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserProfile with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserProfileMultiError, or
+// nil if none found.
+func (m *UserProfile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserProfile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Email
+
+	// no validation rules for Role
+
+	// no validation rules for EmailVerified
+
+	// no validation rules for TotpEnabled
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Bio
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserProfileValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserProfileValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserProfileValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserProfileValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserProfileValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserProfileValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserProfileMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserProfileMultiError is an error wrapping multiple validation errors
+// returned by UserProfile.ValidateAll() if the designated constraints aren't met.
+type UserProfileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserProfileMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserProfileMultiError) AllErrors() []error { return m }
+
+// UserProfileValidationError is the validation error returned by
+// UserProfile.Validate if the designated constraints aren't met.
+type UserProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserProfileValidationError) ErrorName() string { return "UserProfileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserProfile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserProfileValidationError{}
+
+// Validate checks the field values on GetUserReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetUserReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetUserReqMultiError, or
+// nil if none found.
+func (m *GetUserReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetRole()); l < 1 || l > 50 {
+		err := GetUserReqValidationError{
+			field:  "Role",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = GetUserReqValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetTargetId()); err != nil {
+		err = GetUserReqValidationError{
+			field:  "TargetId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetUserReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetUserReqMultiError is an error wrapping multiple validation errors
+// returned by GetUserReq.ValidateAll() if the designated constraints aren't met.
+type GetUserReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserReqMultiError) AllErrors() []error { return m }
+
+// GetUserReqValidationError is the validation error returned by
+// GetUserReq.Validate if the designated constraints aren't met.
+type GetUserReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserReqValidationError) ErrorName() string { return "GetUserReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserReqValidationError{}
+
+// Validate checks the field values on GetUserRes with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetUserRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetUserResMultiError, or
+// nil if none found.
+func (m *GetUserRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserResValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserResValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserResValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetUserResMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserResMultiError is an error wrapping multiple validation errors
+// returned by GetUserRes.ValidateAll() if the designated constraints aren't met.
+type GetUserResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserResMultiError) AllErrors() []error { return m }
+
+// GetUserResValidationError is the validation error returned by
+// GetUserRes.Validate if the designated constraints aren't met.
+type GetUserResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserResValidationError) ErrorName() string { return "GetUserResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserResValidationError{}
+
+// Validate checks the field values on UpdateUserReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UpdateUserReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUserReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UpdateUserReqMultiError, or
+// nil if none found.
+func (m *UpdateUserReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUserReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = UpdateUserReqValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Name != nil {
+
+		if l := utf8.RuneCountInString(m.GetName()); l < 2 || l > 50 {
+			err := UpdateUserReqValidationError{
+				field:  "Name",
+				reason: "value length must be between 2 and 50 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Email != nil {
+
+		if err := m._validateEmail(m.GetEmail()); err != nil {
+			err = UpdateUserReqValidationError{
+				field:  "Email",
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.DisplayName != nil {
+
+		if utf8.RuneCountInString(m.GetDisplayName()) > 100 {
+			err := UpdateUserReqValidationError{
+				field:  "DisplayName",
+				reason: "value length must be at most 100 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Bio != nil {
+
+		if utf8.RuneCountInString(m.GetBio()) > 1000 {
+			err := UpdateUserReqValidationError{
+				field:  "Bio",
+				reason: "value length must be at most 1000 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateUserReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateUserReq) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *UpdateUserReq) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+func (m *UpdateUserReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateUserReqMultiError is an error wrapping multiple validation errors
+// returned by UpdateUserReq.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateUserReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUserReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUserReqMultiError) AllErrors() []error { return m }
+
+// UpdateUserReqValidationError is the validation error returned by
+// UpdateUserReq.Validate if the designated constraints aren't met.
+type UpdateUserReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUserReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUserReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUserReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUserReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUserReqValidationError) ErrorName() string { return "UpdateUserReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateUserReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUserReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUserReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUserReqValidationError{}
+
+// Validate checks the field values on UpdateUserRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UpdateUserRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUserRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UpdateUserResMultiError, or
+// nil if none found.
+func (m *UpdateUserRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUserRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateUserResValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateUserResValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateUserResValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateUserResMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUserResMultiError is an error wrapping multiple validation errors
+// returned by UpdateUserRes.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateUserResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUserResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUserResMultiError) AllErrors() []error { return m }
+
+// UpdateUserResValidationError is the validation error returned by
+// UpdateUserRes.Validate if the designated constraints aren't met.
+type UpdateUserResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUserResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUserResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUserResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUserResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUserResValidationError) ErrorName() string { return "UpdateUserResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateUserResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUserRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUserResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUserResValidationError{}
+
+// Validate checks the field values on ListUsersReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListUsersReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsersReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListUsersReqMultiError, or
+// nil if none found.
+func (m *ListUsersReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsersReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetRole()); l < 1 || l > 50 {
+		err := ListUsersReqValidationError{
+			field:  "Role",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetQuery()) > 100 {
+		err := ListUsersReqValidationError{
+			field:  "Query",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRoleFilter()) > 50 {
+		err := ListUsersReqValidationError{
+			field:  "RoleFilter",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListUsersReqValidationError{
+					field:  "CreatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListUsersReqValidationError{
+					field:  "CreatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListUsersReqValidationError{
+				field:  "CreatedFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListUsersReqValidationError{
+					field:  "CreatedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListUsersReqValidationError{
+					field:  "CreatedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListUsersReqValidationError{
+				field:  "CreatedTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetCursor()) > 200 {
+		err := ListUsersReqValidationError{
+			field:  "Cursor",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() > 200 {
+		err := ListUsersReqValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 200",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListUsersReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsersReqMultiError is an error wrapping multiple validation errors
+// returned by ListUsersReq.ValidateAll() if the designated constraints aren't met.
+type ListUsersReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsersReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsersReqMultiError) AllErrors() []error { return m }
+
+// ListUsersReqValidationError is the validation error returned by
+// ListUsersReq.Validate if the designated constraints aren't met.
+type ListUsersReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsersReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsersReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsersReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsersReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsersReqValidationError) ErrorName() string { return "ListUsersReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListUsersReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsersReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsersReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsersReqValidationError{}
+
+// Validate checks the field values on ListUsersRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListUsersRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsersRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListUsersResMultiError, or
+// nil if none found.
+func (m *ListUsersRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsersRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUsersResValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUsersResValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUsersResValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListUsersResMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsersResMultiError is an error wrapping multiple validation errors
+// returned by ListUsersRes.ValidateAll() if the designated constraints aren't met.
+type ListUsersResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsersResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsersResMultiError) AllErrors() []error { return m }
+
+// ListUsersResValidationError is the validation error returned by
+// ListUsersRes.Validate if the designated constraints aren't met.
+type ListUsersResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsersResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsersResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsersResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsersResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsersResValidationError) ErrorName() string { return "ListUsersResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListUsersResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsersRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsersResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsersResValidationError{}
+
+// Validate checks the field values on SetUserRoleReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SetUserRoleReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetUserRoleReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SetUserRoleReqMultiError,
+// or nil if none found.
+func (m *SetUserRoleReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetUserRoleReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetRole()); l < 1 || l > 50 {
+		err := SetUserRoleReqValidationError{
+			field:  "Role",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = SetUserRoleReqValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetTargetId()); err != nil {
+		err = SetUserRoleReqValidationError{
+			field:  "TargetId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetNewRole()); l < 1 || l > 50 {
+		err := SetUserRoleReqValidationError{
+			field:  "NewRole",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetUserRoleReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SetUserRoleReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SetUserRoleReqMultiError is an error wrapping multiple validation errors
+// returned by SetUserRoleReq.ValidateAll() if the designated constraints
+// aren't met.
+type SetUserRoleReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetUserRoleReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetUserRoleReqMultiError) AllErrors() []error { return m }
+
+// SetUserRoleReqValidationError is the validation error returned by
+// SetUserRoleReq.Validate if the designated constraints aren't met.
+type SetUserRoleReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetUserRoleReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetUserRoleReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetUserRoleReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetUserRoleReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetUserRoleReqValidationError) ErrorName() string { return "SetUserRoleReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SetUserRoleReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetUserRoleReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetUserRoleReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetUserRoleReqValidationError{}
+
+// Validate checks the field values on SetUserRoleRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SetUserRoleRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetUserRoleRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SetUserRoleResMultiError,
+// or nil if none found.
+func (m *SetUserRoleRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetUserRoleRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetUserRoleResMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetUserRoleResMultiError is an error wrapping multiple validation errors
+// returned by SetUserRoleRes.ValidateAll() if the designated constraints
+// aren't met.
+type SetUserRoleResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetUserRoleResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetUserRoleResMultiError) AllErrors() []error { return m }
+
+// SetUserRoleResValidationError is the validation error returned by
+// SetUserRoleRes.Validate if the designated constraints aren't met.
+type SetUserRoleResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetUserRoleResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetUserRoleResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetUserRoleResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetUserRoleResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetUserRoleResValidationError) ErrorName() string { return "SetUserRoleResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SetUserRoleResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetUserRoleRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetUserRoleResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetUserRoleResValidationError{}
+
+// Validate checks the field values on ImpersonateReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImpersonateReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImpersonateReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImpersonateReqMultiError,
+// or nil if none found.
+func (m *ImpersonateReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImpersonateReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetRole()); l < 1 || l > 50 {
+		err := ImpersonateReqValidationError{
+			field:  "Role",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ImpersonateReqValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetTargetId()); err != nil {
+		err = ImpersonateReqValidationError{
+			field:  "TargetId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 3 || l > 500 {
+		err := ImpersonateReqValidationError{
+			field:  "Reason",
+			reason: "value length must be between 3 and 500 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ImpersonateReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ImpersonateReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ImpersonateReqMultiError is an error wrapping multiple validation errors
+// returned by ImpersonateReq.ValidateAll() if the designated constraints
+// aren't met.
+type ImpersonateReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImpersonateReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImpersonateReqMultiError) AllErrors() []error { return m }
+
+// ImpersonateReqValidationError is the validation error returned by
+// ImpersonateReq.Validate if the designated constraints aren't met.
+type ImpersonateReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImpersonateReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImpersonateReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImpersonateReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImpersonateReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImpersonateReqValidationError) ErrorName() string { return "ImpersonateReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImpersonateReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImpersonateReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImpersonateReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImpersonateReqValidationError{}
+
+// Validate checks the field values on ImpersonateRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImpersonateRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImpersonateRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImpersonateResMultiError,
+// or nil if none found.
+func (m *ImpersonateRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImpersonateRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for SessionKey
+
+	// no validation rules for ExpiresIn
+
+	if len(errors) > 0 {
+		return ImpersonateResMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImpersonateResMultiError is an error wrapping multiple validation errors
+// returned by ImpersonateRes.ValidateAll() if the designated constraints
+// aren't met.
+type ImpersonateResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImpersonateResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImpersonateResMultiError) AllErrors() []error { return m }
+
+// ImpersonateResValidationError is the validation error returned by
+// ImpersonateRes.Validate if the designated constraints aren't met.
+type ImpersonateResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImpersonateResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImpersonateResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImpersonateResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImpersonateResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImpersonateResValidationError) ErrorName() string { return "ImpersonateResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImpersonateResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImpersonateRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImpersonateResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImpersonateResValidationError{}
+
+// Validate checks the field values on Deletion with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Deletion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Deletion with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeletionMultiError, or nil
+// if none found.
+func (m *Deletion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Deletion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for RequestedBy
+
+	// no validation rules for State
+
+	// no validation rules for Attempts
+
+	// no validation rules for LastError
+
+	// no validation rules for OrdersCancelled
+
+	// no validation rules for OrdersAnonymised
+
+	if all {
+		switch v := interface{}(m.GetNextAttemptAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeletionValidationError{
+					field:  "NextAttemptAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeletionValidationError{
+					field:  "NextAttemptAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextAttemptAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeletionValidationError{
+				field:  "NextAttemptAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeletionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeletionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeletionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeletionValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeletionValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeletionValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeletionMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletionMultiError is an error wrapping multiple validation errors returned
+// by Deletion.ValidateAll() if the designated constraints aren't met.
+type DeletionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletionMultiError) AllErrors() []error { return m }
+
+// DeletionValidationError is the validation error returned by
+// Deletion.Validate if the designated constraints aren't met.
+type DeletionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletionValidationError) ErrorName() string { return "DeletionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeletionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletionValidationError{}
+
+// Validate checks the field values on ListDeletionsReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListDeletionsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeletionsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeletionsReqMultiError, or nil if none found.
+func (m *ListDeletionsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeletionsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetRole()); l < 1 || l > 50 {
+		err := ListDeletionsReqValidationError{
+			field:  "Role",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetId() != "" {
+
+		if err := m._validateUuid(m.GetId()); err != nil {
+			err = ListDeletionsReqValidationError{
+				field:  "Id",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for All
+
+	if m.GetLimit() > 100 {
+		err := ListDeletionsReqValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListDeletionsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListDeletionsReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListDeletionsReqMultiError is an error wrapping multiple validation errors
+// returned by ListDeletionsReq.ValidateAll() if the designated constraints
+// aren't met.
+type ListDeletionsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeletionsReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeletionsReqMultiError) AllErrors() []error { return m }
+
+// ListDeletionsReqValidationError is the validation error returned by
+// ListDeletionsReq.Validate if the designated constraints aren't met.
+type ListDeletionsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeletionsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeletionsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeletionsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeletionsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeletionsReqValidationError) ErrorName() string { return "ListDeletionsReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListDeletionsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeletionsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeletionsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeletionsReqValidationError{}
+
+// Validate checks the field values on ListDeletionsRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListDeletionsRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeletionsRes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeletionsResMultiError, or nil if none found.
+func (m *ListDeletionsRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeletionsRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDeletions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeletionsResValidationError{
+						field:  fmt.Sprintf("Deletions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeletionsResValidationError{
+						field:  fmt.Sprintf("Deletions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeletionsResValidationError{
+					field:  fmt.Sprintf("Deletions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListDeletionsResMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeletionsResMultiError is an error wrapping multiple validation errors
+// returned by ListDeletionsRes.ValidateAll() if the designated constraints
+// aren't met.
+type ListDeletionsResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeletionsResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeletionsResMultiError) AllErrors() []error { return m }
+
+// ListDeletionsResValidationError is the validation error returned by
+// ListDeletionsRes.Validate if the designated constraints aren't met.
+type ListDeletionsResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeletionsResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeletionsResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeletionsResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeletionsResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeletionsResValidationError) ErrorName() string { return "ListDeletionsResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListDeletionsResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeletionsRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeletionsResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeletionsResValidationError{}
+
+// Validate checks the field values on Invite with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Invite) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Invite with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in InviteMultiError, or nil if none found.
+func (m *Invite) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Invite) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Role
+
+	// no validation rules for Note
+
+	// no validation rules for MaxUses
+
+	// no validation rules for Uses
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InviteValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InviteValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InviteValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CreatedBy
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InviteValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InviteValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InviteValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRevokedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InviteValidationError{
+					field:  "RevokedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InviteValidationError{
+					field:  "RevokedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevokedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InviteValidationError{
+				field:  "RevokedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InviteMultiError(errors)
+	}
+
+	return nil
+}
+
+// InviteMultiError is an error wrapping multiple validation errors returned by
+// Invite.ValidateAll() if the designated constraints aren't met.
+type InviteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InviteMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InviteMultiError) AllErrors() []error { return m }
+
+// InviteValidationError is the validation error returned by Invite.Validate if
+// the designated constraints aren't met.
+type InviteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteValidationError) ErrorName() string { return "InviteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InviteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvite.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteValidationError{}
+
+// Validate checks the field values on CreateInviteReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateInviteReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateInviteReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateInviteReqMultiError, or nil if none found.
+func (m *CreateInviteReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateInviteReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetRole()); l < 1 || l > 50 {
+		err := CreateInviteReqValidationError{
+			field:  "Role",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = CreateInviteReqValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetInviteRole()); l < 1 || l > 50 {
+		err := CreateInviteReqValidationError{
+			field:  "InviteRole",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNote()) > 200 {
+		err := CreateInviteReqValidationError{
+			field:  "Note",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMaxUses(); val < 1 || val > 10000 {
+		err := CreateInviteReqValidationError{
+			field:  "MaxUses",
+			reason: "value must be inside range [1, 10000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetTtlHours(); val < 1 || val > 8760 {
+		err := CreateInviteReqValidationError{
+			field:  "TtlHours",
+			reason: "value must be inside range [1, 8760]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateInviteReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateInviteReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateInviteReqMultiError is an error wrapping multiple validation errors
+// returned by CreateInviteReq.ValidateAll() if the designated constraints
+// aren't met.
+type CreateInviteReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateInviteReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateInviteReqMultiError) AllErrors() []error { return m }
+
+// CreateInviteReqValidationError is the validation error returned by
+// CreateInviteReq.Validate if the designated constraints aren't met.
+type CreateInviteReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateInviteReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateInviteReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateInviteReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateInviteReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateInviteReqValidationError) ErrorName() string { return "CreateInviteReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateInviteReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateInviteReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateInviteReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateInviteReqValidationError{}
+
+// Validate checks the field values on CreateInviteRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateInviteRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateInviteRes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateInviteResMultiError, or nil if none found.
+func (m *CreateInviteRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateInviteRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInvite()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateInviteResValidationError{
+					field:  "Invite",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateInviteResValidationError{
+					field:  "Invite",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvite()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateInviteResValidationError{
+				field:  "Invite",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return CreateInviteResMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateInviteResMultiError is an error wrapping multiple validation errors
+// returned by CreateInviteRes.ValidateAll() if the designated constraints
+// aren't met.
+type CreateInviteResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateInviteResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateInviteResMultiError) AllErrors() []error { return m }
+
+// CreateInviteResValidationError is the validation error returned by
+// CreateInviteRes.Validate if the designated constraints aren't met.
+type CreateInviteResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateInviteResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateInviteResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateInviteResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateInviteResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateInviteResValidationError) ErrorName() string { return "CreateInviteResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateInviteResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateInviteRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateInviteResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateInviteResValidationError{}
+
+// Validate checks the field values on ListInvitesReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListInvitesReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInvitesReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListInvitesReqMultiError,
+// or nil if none found.
+func (m *ListInvitesReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInvitesReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetRole()); l < 1 || l > 50 {
+		err := ListInvitesReqValidationError{
+			field:  "Role",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for All
+
+	if len(errors) > 0 {
+		return ListInvitesReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInvitesReqMultiError is an error wrapping multiple validation errors
+// returned by ListInvitesReq.ValidateAll() if the designated constraints
+// aren't met.
+type ListInvitesReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInvitesReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInvitesReqMultiError) AllErrors() []error { return m }
+
+// ListInvitesReqValidationError is the validation error returned by
+// ListInvitesReq.Validate if the designated constraints aren't met.
+type ListInvitesReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInvitesReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInvitesReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInvitesReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInvitesReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInvitesReqValidationError) ErrorName() string { return "ListInvitesReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListInvitesReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInvitesReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInvitesReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInvitesReqValidationError{}
+
+// Validate checks the field values on ListInvitesRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListInvitesRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInvitesRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListInvitesResMultiError,
+// or nil if none found.
+func (m *ListInvitesRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInvitesRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetInvites() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListInvitesResValidationError{
+						field:  fmt.Sprintf("Invites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListInvitesResValidationError{
+						field:  fmt.Sprintf("Invites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListInvitesResValidationError{
+					field:  fmt.Sprintf("Invites[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListInvitesResMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInvitesResMultiError is an error wrapping multiple validation errors
+// returned by ListInvitesRes.ValidateAll() if the designated constraints
+// aren't met.
+type ListInvitesResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInvitesResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInvitesResMultiError) AllErrors() []error { return m }
+
+// ListInvitesResValidationError is the validation error returned by
+// ListInvitesRes.Validate if the designated constraints aren't met.
+type ListInvitesResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInvitesResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInvitesResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInvitesResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInvitesResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInvitesResValidationError) ErrorName() string { return "ListInvitesResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListInvitesResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInvitesRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInvitesResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInvitesResValidationError{}
+
+// Validate checks the field values on Redemption with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Redemption) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Redemption with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RedemptionMultiError, or
+// nil if none found.
+func (m *Redemption) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Redemption) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetRedeemedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RedemptionValidationError{
+					field:  "RedeemedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RedemptionValidationError{
+					field:  "RedeemedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRedeemedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RedemptionValidationError{
+				field:  "RedeemedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RedemptionMultiError(errors)
+	}
+
+	return nil
+}
+
+// RedemptionMultiError is an error wrapping multiple validation errors
+// returned by Redemption.ValidateAll() if the designated constraints aren't met.
+type RedemptionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RedemptionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RedemptionMultiError) AllErrors() []error { return m }
+
+// RedemptionValidationError is the validation error returned by
+// Redemption.Validate if the designated constraints aren't met.
+type RedemptionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RedemptionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RedemptionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RedemptionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RedemptionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RedemptionValidationError) ErrorName() string { return "RedemptionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RedemptionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRedemption.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RedemptionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RedemptionValidationError{}
+
+// Validate checks the field values on ListRedemptionsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRedemptionsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRedemptionsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRedemptionsReqMultiError, or nil if none found.
+func (m *ListRedemptionsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRedemptionsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetRole()); l < 1 || l > 50 {
+		err := ListRedemptionsReqValidationError{
+			field:  "Role",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetInviteId()); err != nil {
+		err = ListRedemptionsReqValidationError{
+			field:  "InviteId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListRedemptionsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListRedemptionsReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListRedemptionsReqMultiError is an error wrapping multiple validation errors
+// returned by ListRedemptionsReq.ValidateAll() if the designated constraints
+// aren't met.
+type ListRedemptionsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRedemptionsReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRedemptionsReqMultiError) AllErrors() []error { return m }
+
+// ListRedemptionsReqValidationError is the validation error returned by
+// ListRedemptionsReq.Validate if the designated constraints aren't met.
+type ListRedemptionsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRedemptionsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRedemptionsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRedemptionsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRedemptionsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRedemptionsReqValidationError) ErrorName() string {
+	return "ListRedemptionsReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRedemptionsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRedemptionsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRedemptionsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRedemptionsReqValidationError{}
+
+// Validate checks the field values on ListRedemptionsRes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRedemptionsRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRedemptionsRes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRedemptionsResMultiError, or nil if none found.
+func (m *ListRedemptionsRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRedemptionsRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRedemptions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRedemptionsResValidationError{
+						field:  fmt.Sprintf("Redemptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRedemptionsResValidationError{
+						field:  fmt.Sprintf("Redemptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRedemptionsResValidationError{
+					field:  fmt.Sprintf("Redemptions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRedemptionsResMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRedemptionsResMultiError is an error wrapping multiple validation errors
+// returned by ListRedemptionsRes.ValidateAll() if the designated constraints
+// aren't met.
+type ListRedemptionsResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRedemptionsResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRedemptionsResMultiError) AllErrors() []error { return m }
+
+// ListRedemptionsResValidationError is the validation error returned by
+// ListRedemptionsRes.Validate if the designated constraints aren't met.
+type ListRedemptionsResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRedemptionsResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRedemptionsResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRedemptionsResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRedemptionsResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRedemptionsResValidationError) ErrorName() string {
+	return "ListRedemptionsResValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRedemptionsResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRedemptionsRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRedemptionsResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRedemptionsResValidationError{}
+
+// Validate checks the field values on RevokeInviteReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RevokeInviteReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeInviteReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeInviteReqMultiError, or nil if none found.
+func (m *RevokeInviteReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeInviteReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetRole()); l < 1 || l > 50 {
+		err := RevokeInviteReqValidationError{
+			field:  "Role",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RevokeInviteReqValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetInviteId()); err != nil {
+		err = RevokeInviteReqValidationError{
+			field:  "InviteId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeInviteReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RevokeInviteReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RevokeInviteReqMultiError is an error wrapping multiple validation errors
+// returned by RevokeInviteReq.ValidateAll() if the designated constraints
+// aren't met.
+type RevokeInviteReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeInviteReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeInviteReqMultiError) AllErrors() []error { return m }
+
+// RevokeInviteReqValidationError is the validation error returned by
+// RevokeInviteReq.Validate if the designated constraints aren't met.
+type RevokeInviteReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeInviteReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeInviteReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeInviteReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeInviteReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeInviteReqValidationError) ErrorName() string { return "RevokeInviteReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RevokeInviteReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeInviteReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeInviteReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeInviteReqValidationError{}
+
+// Validate checks the field values on RevokeInviteRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RevokeInviteRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeInviteRes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeInviteResMultiError, or nil if none found.
+func (m *RevokeInviteRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeInviteRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeInviteResMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeInviteResMultiError is an error wrapping multiple validation errors
+// returned by RevokeInviteRes.ValidateAll() if the designated constraints
+// aren't met.
+type RevokeInviteResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeInviteResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeInviteResMultiError) AllErrors() []error { return m }
+
+// RevokeInviteResValidationError is the validation error returned by
+// RevokeInviteRes.Validate if the designated constraints aren't met.
+type RevokeInviteResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeInviteResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeInviteResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeInviteResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeInviteResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeInviteResValidationError) ErrorName() string { return "RevokeInviteResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RevokeInviteResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeInviteRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeInviteResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeInviteResValidationError{}
+
+// Validate checks the field values on ExportUserDataReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExportUserDataReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportUserDataReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportUserDataReqMultiError, or nil if none found.
+func (m *ExportUserDataReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportUserDataReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetRole()); l < 1 || l > 50 {
+		err := ExportUserDataReqValidationError{
+			field:  "Role",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ExportUserDataReqValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetTargetId()); err != nil {
+		err = ExportUserDataReqValidationError{
+			field:  "TargetId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportUserDataReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ExportUserDataReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ExportUserDataReqMultiError is an error wrapping multiple validation errors
+// returned by ExportUserDataReq.ValidateAll() if the designated constraints
+// aren't met.
+type ExportUserDataReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportUserDataReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportUserDataReqMultiError) AllErrors() []error { return m }
+
+// ExportUserDataReqValidationError is the validation error returned by
+// ExportUserDataReq.Validate if the designated constraints aren't met.
+type ExportUserDataReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportUserDataReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportUserDataReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportUserDataReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportUserDataReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportUserDataReqValidationError) ErrorName() string {
+	return "ExportUserDataReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportUserDataReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportUserDataReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportUserDataReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportUserDataReqValidationError{}
+
+// Validate checks the field values on ExportUserDataRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExportUserDataRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportUserDataRes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportUserDataResMultiError, or nil if none found.
+func (m *ExportUserDataRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportUserDataRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Archive
+
+	// no validation rules for Filename
+
+	if len(errors) > 0 {
+		return ExportUserDataResMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportUserDataResMultiError is an error wrapping multiple validation errors
+// returned by ExportUserDataRes.ValidateAll() if the designated constraints
+// aren't met.
+type ExportUserDataResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportUserDataResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportUserDataResMultiError) AllErrors() []error { return m }
+
+// ExportUserDataResValidationError is the validation error returned by
+// ExportUserDataRes.Validate if the designated constraints aren't met.
+type ExportUserDataResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportUserDataResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportUserDataResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportUserDataResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportUserDataResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportUserDataResValidationError) ErrorName() string {
+	return "ExportUserDataResValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportUserDataResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportUserDataRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportUserDataResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportUserDataResValidationError{}
+
+// Validate checks the field values on ErasureRecord with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ErasureRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ErasureRecord with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ErasureRecordMultiError, or
+// nil if none found.
+func (m *ErasureRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErasureRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for SubjectHash
+
+	// no validation rules for RequestedBy
+
+	// no validation rules for Steps
+
+	if all {
+		switch v := interface{}(m.GetCompletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ErasureRecordValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ErasureRecordValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCompletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ErasureRecordValidationError{
+				field:  "CompletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Receipt
+
+	if len(errors) > 0 {
+		return ErasureRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErasureRecordMultiError is an error wrapping multiple validation errors
+// returned by ErasureRecord.ValidateAll() if the designated constraints
+// aren't met.
+type ErasureRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErasureRecordMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErasureRecordMultiError) AllErrors() []error { return m }
+
+// ErasureRecordValidationError is the validation error returned by
+// ErasureRecord.Validate if the designated constraints aren't met.
+type ErasureRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErasureRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ErasureRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ErasureRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ErasureRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ErasureRecordValidationError) ErrorName() string { return "ErasureRecordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ErasureRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sErasureRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ErasureRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErasureRecordValidationError{}
+
+// Validate checks the field values on EraseUserReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EraseUserReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EraseUserReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EraseUserReqMultiError, or
+// nil if none found.
+func (m *EraseUserReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EraseUserReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetRole()); l < 1 || l > 50 {
+		err := EraseUserReqValidationError{
+			field:  "Role",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = EraseUserReqValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetTargetId()); err != nil {
+		err = EraseUserReqValidationError{
+			field:  "TargetId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return EraseUserReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *EraseUserReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// EraseUserReqMultiError is an error wrapping multiple validation errors
+// returned by EraseUserReq.ValidateAll() if the designated constraints aren't met.
+type EraseUserReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EraseUserReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EraseUserReqMultiError) AllErrors() []error { return m }
+
+// EraseUserReqValidationError is the validation error returned by
+// EraseUserReq.Validate if the designated constraints aren't met.
+type EraseUserReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EraseUserReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EraseUserReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EraseUserReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EraseUserReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EraseUserReqValidationError) ErrorName() string { return "EraseUserReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EraseUserReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEraseUserReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EraseUserReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EraseUserReqValidationError{}
+
+// Validate checks the field values on EraseUserRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EraseUserRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EraseUserRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EraseUserResMultiError, or
+// nil if none found.
+func (m *EraseUserRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EraseUserRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRecord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EraseUserResValidationError{
+					field:  "Record",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EraseUserResValidationError{
+					field:  "Record",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EraseUserResValidationError{
+				field:  "Record",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EraseUserResMultiError(errors)
+	}
+
+	return nil
+}
+
+// EraseUserResMultiError is an error wrapping multiple validation errors
+// returned by EraseUserRes.ValidateAll() if the designated constraints aren't met.
+type EraseUserResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EraseUserResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EraseUserResMultiError) AllErrors() []error { return m }
+
+// EraseUserResValidationError is the validation error returned by
+// EraseUserRes.Validate if the designated constraints aren't met.
+type EraseUserResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EraseUserResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EraseUserResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EraseUserResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EraseUserResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EraseUserResValidationError) ErrorName() string { return "EraseUserResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EraseUserResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEraseUserRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EraseUserResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EraseUserResValidationError{}
+
+// Validate checks the field values on GetErasureReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetErasureReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetErasureReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetErasureReqMultiError, or
+// nil if none found.
+func (m *GetErasureReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetErasureReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetErasureReqValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetErasureReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetErasureReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetErasureReqMultiError is an error wrapping multiple validation errors
+// returned by GetErasureReq.ValidateAll() if the designated constraints
+// aren't met.
+type GetErasureReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetErasureReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetErasureReqMultiError) AllErrors() []error { return m }
+
+// GetErasureReqValidationError is the validation error returned by
+// GetErasureReq.Validate if the designated constraints aren't met.
+type GetErasureReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetErasureReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetErasureReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetErasureReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetErasureReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetErasureReqValidationError) ErrorName() string { return "GetErasureReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetErasureReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetErasureReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetErasureReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetErasureReqValidationError{}
+
+// Validate checks the field values on GetErasureRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetErasureRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetErasureRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetErasureResMultiError, or
+// nil if none found.
+func (m *GetErasureRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetErasureRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRecord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetErasureResValidationError{
+					field:  "Record",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetErasureResValidationError{
+					field:  "Record",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetErasureResValidationError{
+				field:  "Record",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetErasureResMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetErasureResMultiError is an error wrapping multiple validation errors
+// returned by GetErasureRes.ValidateAll() if the designated constraints
+// aren't met.
+type GetErasureResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetErasureResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetErasureResMultiError) AllErrors() []error { return m }
+
+// GetErasureResValidationError is the validation error returned by
+// GetErasureRes.Validate if the designated constraints aren't met.
+type GetErasureResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetErasureResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetErasureResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetErasureResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetErasureResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetErasureResValidationError) ErrorName() string { return "GetErasureResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetErasureResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetErasureRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetErasureResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetErasureResValidationError{}
+
+// Validate checks the field values on APIKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *APIKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on APIKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in APIKeyMultiError, or nil if none found.
+func (m *APIKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *APIKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for RateLimit
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastUsedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastUsedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyValidationError{
+				field:  "LastUsedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return APIKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// APIKeyMultiError is an error wrapping multiple validation errors returned by
+// APIKey.ValidateAll() if the designated constraints aren't met.
+type APIKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m APIKeyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m APIKeyMultiError) AllErrors() []error { return m }
+
+// APIKeyValidationError is the validation error returned by APIKey.Validate if
+// the designated constraints aren't met.
+type APIKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e APIKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e APIKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e APIKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e APIKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e APIKeyValidationError) ErrorName() string { return "APIKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e APIKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAPIKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = APIKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = APIKeyValidationError{}
+
+// Validate checks the field values on CreateAPIKeyReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateAPIKeyReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAPIKeyReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAPIKeyReqMultiError, or nil if none found.
+func (m *CreateAPIKeyReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAPIKeyReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetRole()); l < 1 || l > 50 {
+		err := CreateAPIKeyReqValidationError{
+			field:  "Role",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = CreateAPIKeyReqValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 50 {
+		err := CreateAPIKeyReqValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetScopes()) < 1 {
+		err := CreateAPIKeyReqValidationError{
+			field:  "Scopes",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_CreateAPIKeyReq_Scopes_Unique := make(map[string]struct{}, len(m.GetScopes()))
+
+	for idx, item := range m.GetScopes() {
+		_, _ = idx, item
+
+		if _, exists := _CreateAPIKeyReq_Scopes_Unique[item]; exists {
+			err := CreateAPIKeyReqValidationError{
+				field:  fmt.Sprintf("Scopes[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CreateAPIKeyReq_Scopes_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for Scopes[idx]
+	}
+
+	if m.GetRateLimit() > 10000 {
+		err := CreateAPIKeyReqValidationError{
+			field:  "RateLimit",
+			reason: "value must be less than or equal to 10000",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateAPIKeyReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateAPIKeyReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateAPIKeyReqMultiError is an error wrapping multiple validation errors
+// returned by CreateAPIKeyReq.ValidateAll() if the designated constraints
+// aren't met.
+type CreateAPIKeyReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAPIKeyReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAPIKeyReqMultiError) AllErrors() []error { return m }
+
+// CreateAPIKeyReqValidationError is the validation error returned by
+// CreateAPIKeyReq.Validate if the designated constraints aren't met.
+type CreateAPIKeyReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAPIKeyReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAPIKeyReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAPIKeyReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAPIKeyReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAPIKeyReqValidationError) ErrorName() string { return "CreateAPIKeyReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateAPIKeyReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAPIKeyReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAPIKeyReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAPIKeyReqValidationError{}
+
+// Validate checks the field values on CreateAPIKeyRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateAPIKeyRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAPIKeyRes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAPIKeyResMultiError, or nil if none found.
+func (m *CreateAPIKeyRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAPIKeyRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAPIKeyResValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAPIKeyResValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAPIKeyResValidationError{
+				field:  "Key",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Secret
+
+	if len(errors) > 0 {
+		return CreateAPIKeyResMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAPIKeyResMultiError is an error wrapping multiple validation errors
+// returned by CreateAPIKeyRes.ValidateAll() if the designated constraints
+// aren't met.
+type CreateAPIKeyResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAPIKeyResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAPIKeyResMultiError) AllErrors() []error { return m }
+
+// CreateAPIKeyResValidationError is the validation error returned by
+// CreateAPIKeyRes.Validate if the designated constraints aren't met.
+type CreateAPIKeyResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAPIKeyResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAPIKeyResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAPIKeyResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAPIKeyResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAPIKeyResValidationError) ErrorName() string { return "CreateAPIKeyResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateAPIKeyResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAPIKeyRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAPIKeyResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAPIKeyResValidationError{}
+
+// Validate checks the field values on ListAPIKeysReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListAPIKeysReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAPIKeysReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListAPIKeysReqMultiError,
+// or nil if none found.
+func (m *ListAPIKeysReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAPIKeysReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ListAPIKeysReqValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListAPIKeysReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListAPIKeysReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListAPIKeysReqMultiError is an error wrapping multiple validation errors
+// returned by ListAPIKeysReq.ValidateAll() if the designated constraints
+// aren't met.
+type ListAPIKeysReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAPIKeysReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAPIKeysReqMultiError) AllErrors() []error { return m }
+
+// ListAPIKeysReqValidationError is the validation error returned by
+// ListAPIKeysReq.Validate if the designated constraints aren't met.
+type ListAPIKeysReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAPIKeysReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAPIKeysReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAPIKeysReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAPIKeysReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAPIKeysReqValidationError) ErrorName() string { return "ListAPIKeysReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListAPIKeysReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAPIKeysReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAPIKeysReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAPIKeysReqValidationError{}
+
+// Validate checks the field values on ListAPIKeysRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListAPIKeysRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAPIKeysRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListAPIKeysResMultiError,
+// or nil if none found.
+func (m *ListAPIKeysRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAPIKeysRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAPIKeysResValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAPIKeysResValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAPIKeysResValidationError{
+					field:  fmt.Sprintf("Keys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAPIKeysResMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAPIKeysResMultiError is an error wrapping multiple validation errors
+// returned by ListAPIKeysRes.ValidateAll() if the designated constraints
+// aren't met.
+type ListAPIKeysResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAPIKeysResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAPIKeysResMultiError) AllErrors() []error { return m }
+
+// ListAPIKeysResValidationError is the validation error returned by
+// ListAPIKeysRes.Validate if the designated constraints aren't met.
+type ListAPIKeysResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAPIKeysResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAPIKeysResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAPIKeysResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAPIKeysResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAPIKeysResValidationError) ErrorName() string { return "ListAPIKeysResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListAPIKeysResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAPIKeysRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAPIKeysResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAPIKeysResValidationError{}
+
+// Validate checks the field values on RevokeAPIKeyReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAPIKeyReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAPIKeyReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeAPIKeyReqMultiError, or nil if none found.
+func (m *RevokeAPIKeyReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAPIKeyReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RevokeAPIKeyReqValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetKeyId()); err != nil {
+		err = RevokeAPIKeyReqValidationError{
+			field:  "KeyId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeAPIKeyReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RevokeAPIKeyReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RevokeAPIKeyReqMultiError is an error wrapping multiple validation errors
+// returned by RevokeAPIKeyReq.ValidateAll() if the designated constraints
+// aren't met.
+type RevokeAPIKeyReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeAPIKeyReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeAPIKeyReqMultiError) AllErrors() []error { return m }
+
+// RevokeAPIKeyReqValidationError is the validation error returned by
+// RevokeAPIKeyReq.Validate if the designated constraints aren't met.
+type RevokeAPIKeyReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAPIKeyReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAPIKeyReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAPIKeyReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAPIKeyReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAPIKeyReqValidationError) ErrorName() string { return "RevokeAPIKeyReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RevokeAPIKeyReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAPIKeyReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAPIKeyReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAPIKeyReqValidationError{}
+
+// Validate checks the field values on RevokeAPIKeyRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAPIKeyRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAPIKeyRes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeAPIKeyResMultiError, or nil if none found.
+func (m *RevokeAPIKeyRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAPIKeyRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeAPIKeyResMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeAPIKeyResMultiError is an error wrapping multiple validation errors
+// returned by RevokeAPIKeyRes.ValidateAll() if the designated constraints
+// aren't met.
+type RevokeAPIKeyResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeAPIKeyResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeAPIKeyResMultiError) AllErrors() []error { return m }
+
+// RevokeAPIKeyResValidationError is the validation error returned by
+// RevokeAPIKeyRes.Validate if the designated constraints aren't met.
+type RevokeAPIKeyResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAPIKeyResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAPIKeyResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAPIKeyResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAPIKeyResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAPIKeyResValidationError) ErrorName() string { return "RevokeAPIKeyResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RevokeAPIKeyResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAPIKeyRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAPIKeyResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAPIKeyResValidationError{}
+
+// Validate checks the field values on AuthAPIKeyReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuthAPIKeyReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthAPIKeyReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuthAPIKeyReqMultiError, or
+// nil if none found.
+func (m *AuthAPIKeyReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthAPIKeyReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetKey()); l < 1 || l > 200 {
+		err := AuthAPIKeyReqValidationError{
+			field:  "Key",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AuthAPIKeyReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthAPIKeyReqMultiError is an error wrapping multiple validation errors
+// returned by AuthAPIKeyReq.ValidateAll() if the designated constraints
+// aren't met.
+type AuthAPIKeyReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthAPIKeyReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthAPIKeyReqMultiError) AllErrors() []error { return m }
+
+// AuthAPIKeyReqValidationError is the validation error returned by
+// AuthAPIKeyReq.Validate if the designated constraints aren't met.
+type AuthAPIKeyReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthAPIKeyReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthAPIKeyReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthAPIKeyReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthAPIKeyReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthAPIKeyReqValidationError) ErrorName() string { return "AuthAPIKeyReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthAPIKeyReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthAPIKeyReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthAPIKeyReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthAPIKeyReqValidationError{}
+
+// Validate checks the field values on AuthAPIKeyRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuthAPIKeyRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthAPIKeyRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuthAPIKeyResMultiError, or
+// nil if none found.
+func (m *AuthAPIKeyRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthAPIKeyRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for KeyId
+
+	// no validation rules for UserId
+
+	// no validation rules for Role
+
+	// no validation rules for EmailVerified
+
+	// no validation rules for RateLimit
+
+	if len(errors) > 0 {
+		return AuthAPIKeyResMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthAPIKeyResMultiError is an error wrapping multiple validation errors
+// returned by AuthAPIKeyRes.ValidateAll() if the designated constraints
+// aren't met.
+type AuthAPIKeyResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthAPIKeyResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthAPIKeyResMultiError) AllErrors() []error { return m }
+
+// AuthAPIKeyResValidationError is the validation error returned by
+// AuthAPIKeyRes.Validate if the designated constraints aren't met.
+type AuthAPIKeyResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthAPIKeyResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthAPIKeyResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthAPIKeyResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthAPIKeyResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthAPIKeyResValidationError) ErrorName() string { return "AuthAPIKeyResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthAPIKeyResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthAPIKeyRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthAPIKeyResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthAPIKeyResValidationError{}
+
+// Validate checks the field values on OAuthClient with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OAuthClient) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OAuthClient with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OAuthClientMultiError, or
+// nil if none found.
+func (m *OAuthClient) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OAuthClient) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Confidential
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OAuthClientValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OAuthClientValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OAuthClientValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OAuthClientMultiError(errors)
+	}
+
+	return nil
+}
+
+// OAuthClientMultiError is an error wrapping multiple validation errors
+// returned by OAuthClient.ValidateAll() if the designated constraints aren't met.
+type OAuthClientMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OAuthClientMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OAuthClientMultiError) AllErrors() []error { return m }
+
+// OAuthClientValidationError is the validation error returned by
+// OAuthClient.Validate if the designated constraints aren't met.
+type OAuthClientValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OAuthClientValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OAuthClientValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OAuthClientValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OAuthClientValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OAuthClientValidationError) ErrorName() string { return "OAuthClientValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OAuthClientValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOAuthClient.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OAuthClientValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OAuthClientValidationError{}
+
+// Validate checks the field values on RegisterClientReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RegisterClientReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegisterClientReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegisterClientReqMultiError, or nil if none found.
+func (m *RegisterClientReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegisterClientReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RegisterClientReqValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := RegisterClientReqValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetRedirectUris()); l < 1 || l > 10 {
+		err := RegisterClientReqValidationError{
+			field:  "RedirectUris",
+			reason: "value must contain between 1 and 10 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_RegisterClientReq_RedirectUris_Unique := make(map[string]struct{}, len(m.GetRedirectUris()))
+
+	for idx, item := range m.GetRedirectUris() {
+		_, _ = idx, item
+
+		if _, exists := _RegisterClientReq_RedirectUris_Unique[item]; exists {
+			err := RegisterClientReqValidationError{
+				field:  fmt.Sprintf("RedirectUris[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_RegisterClientReq_RedirectUris_Unique[item] = struct{}{}
+		}
+
+		if uri, err := url.Parse(item); err != nil {
+			err = RegisterClientReqValidationError{
+				field:  fmt.Sprintf("RedirectUris[%v]", idx),
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := RegisterClientReqValidationError{
+				field:  fmt.Sprintf("RedirectUris[%v]", idx),
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetScopes()) < 1 {
+		err := RegisterClientReqValidationError{
+			field:  "Scopes",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_RegisterClientReq_Scopes_Unique := make(map[string]struct{}, len(m.GetScopes()))
+
+	for idx, item := range m.GetScopes() {
+		_, _ = idx, item
+
+		if _, exists := _RegisterClientReq_Scopes_Unique[item]; exists {
+			err := RegisterClientReqValidationError{
+				field:  fmt.Sprintf("Scopes[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_RegisterClientReq_Scopes_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for Scopes[idx]
+	}
+
+	// no validation rules for Confidential
+
+	if len(errors) > 0 {
+		return RegisterClientReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RegisterClientReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RegisterClientReqMultiError is an error wrapping multiple validation errors
+// returned by RegisterClientReq.ValidateAll() if the designated constraints
+// aren't met.
+type RegisterClientReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegisterClientReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegisterClientReqMultiError) AllErrors() []error { return m }
+
+// RegisterClientReqValidationError is the validation error returned by
+// RegisterClientReq.Validate if the designated constraints aren't met.
+type RegisterClientReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegisterClientReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegisterClientReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegisterClientReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegisterClientReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegisterClientReqValidationError) ErrorName() string {
+	return "RegisterClientReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegisterClientReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegisterClientReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegisterClientReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegisterClientReqValidationError{}
+
+// Validate checks the field values on RegisterClientRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RegisterClientRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegisterClientRes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegisterClientResMultiError, or nil if none found.
+func (m *RegisterClientRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegisterClientRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetClient()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RegisterClientResValidationError{
+					field:  "Client",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RegisterClientResValidationError{
+					field:  "Client",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClient()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RegisterClientResValidationError{
+				field:  "Client",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ClientSecret
+
+	if len(errors) > 0 {
+		return RegisterClientResMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegisterClientResMultiError is an error wrapping multiple validation errors
+// returned by RegisterClientRes.ValidateAll() if the designated constraints
+// aren't met.
+type RegisterClientResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegisterClientResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegisterClientResMultiError) AllErrors() []error { return m }
+
+// RegisterClientResValidationError is the validation error returned by
+// RegisterClientRes.Validate if the designated constraints aren't met.
+type RegisterClientResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegisterClientResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegisterClientResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegisterClientResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegisterClientResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegisterClientResValidationError) ErrorName() string {
+	return "RegisterClientResValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegisterClientResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegisterClientRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegisterClientResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegisterClientResValidationError{}
+
+// Validate checks the field values on ListClientsReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListClientsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListClientsReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListClientsReqMultiError,
+// or nil if none found.
+func (m *ListClientsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListClientsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = ListClientsReqValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListClientsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListClientsReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListClientsReqMultiError is an error wrapping multiple validation errors
+// returned by ListClientsReq.ValidateAll() if the designated constraints
+// aren't met.
+type ListClientsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListClientsReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListClientsReqMultiError) AllErrors() []error { return m }
+
+// ListClientsReqValidationError is the validation error returned by
+// ListClientsReq.Validate if the designated constraints aren't met.
+type ListClientsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListClientsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListClientsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListClientsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListClientsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListClientsReqValidationError) ErrorName() string { return "ListClientsReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListClientsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListClientsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListClientsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListClientsReqValidationError{}
+
+// Validate checks the field values on ListClientsRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListClientsRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListClientsRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListClientsResMultiError,
+// or nil if none found.
+func (m *ListClientsRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListClientsRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetClients() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListClientsResValidationError{
+						field:  fmt.Sprintf("Clients[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListClientsResValidationError{
+						field:  fmt.Sprintf("Clients[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListClientsResValidationError{
+					field:  fmt.Sprintf("Clients[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListClientsResMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListClientsResMultiError is an error wrapping multiple validation errors
+// returned by ListClientsRes.ValidateAll() if the designated constraints
+// aren't met.
+type ListClientsResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListClientsResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListClientsResMultiError) AllErrors() []error { return m }
+
+// ListClientsResValidationError is the validation error returned by
+// ListClientsRes.Validate if the designated constraints aren't met.
+type ListClientsResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListClientsResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListClientsResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListClientsResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListClientsResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListClientsResValidationError) ErrorName() string { return "ListClientsResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListClientsResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListClientsRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListClientsResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListClientsResValidationError{}
+
+// Validate checks the field values on DeleteClientReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteClientReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteClientReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteClientReqMultiError, or nil if none found.
+func (m *DeleteClientReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteClientReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = DeleteClientReqValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetClientId()); err != nil {
+		err = DeleteClientReqValidationError{
+			field:  "ClientId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteClientReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteClientReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteClientReqMultiError is an error wrapping multiple validation errors
+// returned by DeleteClientReq.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteClientReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteClientReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteClientReqMultiError) AllErrors() []error { return m }
+
+// DeleteClientReqValidationError is the validation error returned by
+// DeleteClientReq.Validate if the designated constraints aren't met.
+type DeleteClientReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteClientReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteClientReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteClientReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteClientReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteClientReqValidationError) ErrorName() string { return "DeleteClientReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteClientReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteClientReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteClientReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteClientReqValidationError{}
+
+// Validate checks the field values on DeleteClientRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteClientRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteClientRes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteClientResMultiError, or nil if none found.
+func (m *DeleteClientRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteClientRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteClientResMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteClientResMultiError is an error wrapping multiple validation errors
+// returned by DeleteClientRes.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteClientResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteClientResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteClientResMultiError) AllErrors() []error { return m }
+
+// DeleteClientResValidationError is the validation error returned by
+// DeleteClientRes.Validate if the designated constraints aren't met.
+type DeleteClientResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteClientResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteClientResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteClientResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteClientResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteClientResValidationError) ErrorName() string { return "DeleteClientResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteClientResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteClientRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteClientResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteClientResValidationError{}
+
+// Validate checks the field values on AuthorizeReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuthorizeReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorizeReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuthorizeReqMultiError, or
+// nil if none found.
+func (m *AuthorizeReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorizeReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = AuthorizeReqValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetRole()); l < 1 || l > 50 {
+		err := AuthorizeReqValidationError{
+			field:  "Role",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetResponseType() != "code" {
+		err := AuthorizeReqValidationError{
+			field:  "ResponseType",
+			reason: "value must equal code",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetClientId()); err != nil {
+		err = AuthorizeReqValidationError{
+			field:  "ClientId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if uri, err := url.Parse(m.GetRedirectUri()); err != nil {
+		err = AuthorizeReqValidationError{
+			field:  "RedirectUri",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := AuthorizeReqValidationError{
+			field:  "RedirectUri",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetScope()); l < 1 || l > 500 {
+		err := AuthorizeReqValidationError{
+			field:  "Scope",
+			reason: "value length must be between 1 and 500 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetState()) > 500 {
+		err := AuthorizeReqValidationError{
+			field:  "State",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCodeChallenge()); l < 43 || l > 128 {
+		err := AuthorizeReqValidationError{
+			field:  "CodeChallenge",
+			reason: "value length must be between 43 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCodeChallengeMethod() != "S256" {
+		err := AuthorizeReqValidationError{
+			field:  "CodeChallengeMethod",
+			reason: "value must equal S256",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNonce()) > 500 {
+		err := AuthorizeReqValidationError{
+			field:  "Nonce",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Approve
+
+	// no validation rules for ActorId
+
+	if len(errors) > 0 {
+		return AuthorizeReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AuthorizeReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AuthorizeReqMultiError is an error wrapping multiple validation errors
+// returned by AuthorizeReq.ValidateAll() if the designated constraints aren't met.
+type AuthorizeReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorizeReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorizeReqMultiError) AllErrors() []error { return m }
+
+// AuthorizeReqValidationError is the validation error returned by
+// AuthorizeReq.Validate if the designated constraints aren't met.
+type AuthorizeReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorizeReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorizeReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorizeReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorizeReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorizeReqValidationError) ErrorName() string { return "AuthorizeReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthorizeReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorizeReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorizeReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorizeReqValidationError{}
+
+// Validate checks the field values on AuthorizeRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuthorizeRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorizeRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuthorizeResMultiError, or
+// nil if none found.
+func (m *AuthorizeRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorizeRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConsentRequired
+
+	// no validation rules for ClientName
+
+	// no validation rules for RedirectTo
+
+	if len(errors) > 0 {
+		return AuthorizeResMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorizeResMultiError is an error wrapping multiple validation errors
+// returned by AuthorizeRes.ValidateAll() if the designated constraints aren't met.
+type AuthorizeResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorizeResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorizeResMultiError) AllErrors() []error { return m }
+
+// AuthorizeResValidationError is the validation error returned by
+// AuthorizeRes.Validate if the designated constraints aren't met.
+type AuthorizeResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorizeResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorizeResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorizeResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorizeResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorizeResValidationError) ErrorName() string { return "AuthorizeResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthorizeResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorizeRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorizeResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorizeResValidationError{}
+
+// Validate checks the field values on TokenReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TokenReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TokenReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TokenReqMultiError, or nil
+// if none found.
+func (m *TokenReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TokenReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _TokenReq_GrantType_InLookup[m.GetGrantType()]; !ok {
+		err := TokenReqValidationError{
+			field:  "GrantType",
+			reason: "value must be in list [authorization_code client_credentials]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetClientId()); err != nil {
+		err = TokenReqValidationError{
+			field:  "ClientId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetClientSecret()) > 200 {
+		err := TokenReqValidationError{
+			field:  "ClientSecret",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCode()) > 200 {
+		err := TokenReqValidationError{
+			field:  "Code",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRedirectUri()) > 2000 {
+		err := TokenReqValidationError{
+			field:  "RedirectUri",
+			reason: "value length must be at most 2000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCodeVerifier()) > 128 {
+		err := TokenReqValidationError{
+			field:  "CodeVerifier",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetScope()) > 500 {
+		err := TokenReqValidationError{
+			field:  "Scope",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TokenReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *TokenReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// TokenReqMultiError is an error wrapping multiple validation errors returned
+// by TokenReq.ValidateAll() if the designated constraints aren't met.
+type TokenReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TokenReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TokenReqMultiError) AllErrors() []error { return m }
+
+// TokenReqValidationError is the validation error returned by
+// TokenReq.Validate if the designated constraints aren't met.
+type TokenReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TokenReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TokenReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TokenReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TokenReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TokenReqValidationError) ErrorName() string { return "TokenReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TokenReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTokenReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TokenReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TokenReqValidationError{}
+
+var _TokenReq_GrantType_InLookup = map[string]struct{}{
+	"authorization_code": {},
+	"client_credentials": {},
+}
+
+// Validate checks the field values on TokenRes with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TokenRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TokenRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TokenResMultiError, or nil
+// if none found.
+func (m *TokenRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TokenRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccessToken
+
+	// no validation rules for TokenType
+
+	// no validation rules for ExpiresIn
+
+	// no validation rules for Scope
+
+	// no validation rules for IdToken
+
+	if len(errors) > 0 {
+		return TokenResMultiError(errors)
+	}
+
+	return nil
+}
+
+// TokenResMultiError is an error wrapping multiple validation errors returned
+// by TokenRes.ValidateAll() if the designated constraints aren't met.
+type TokenResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TokenResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TokenResMultiError) AllErrors() []error { return m }
+
+// TokenResValidationError is the validation error returned by
+// TokenRes.Validate if the designated constraints aren't met.
+type TokenResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TokenResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TokenResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TokenResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TokenResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TokenResValidationError) ErrorName() string { return "TokenResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TokenResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTokenRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TokenResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TokenResValidationError{}
+
+// Validate checks the field values on AuthAccessTokenReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthAccessTokenReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthAccessTokenReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthAccessTokenReqMultiError, or nil if none found.
+func (m *AuthAccessTokenReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthAccessTokenReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetToken()); l < 1 || l > 4096 {
+		err := AuthAccessTokenReqValidationError{
+			field:  "Token",
+			reason: "value length must be between 1 and 4096 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AuthAccessTokenReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthAccessTokenReqMultiError is an error wrapping multiple validation errors
+// returned by AuthAccessTokenReq.ValidateAll() if the designated constraints
+// aren't met.
+type AuthAccessTokenReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthAccessTokenReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthAccessTokenReqMultiError) AllErrors() []error { return m }
+
+// AuthAccessTokenReqValidationError is the validation error returned by
+// AuthAccessTokenReq.Validate if the designated constraints aren't met.
+type AuthAccessTokenReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthAccessTokenReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthAccessTokenReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthAccessTokenReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthAccessTokenReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthAccessTokenReqValidationError) ErrorName() string {
+	return "AuthAccessTokenReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthAccessTokenReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthAccessTokenReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthAccessTokenReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthAccessTokenReqValidationError{}
+
+// Validate checks the field values on AuthAccessTokenRes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthAccessTokenRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthAccessTokenRes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthAccessTokenResMultiError, or nil if none found.
+func (m *AuthAccessTokenRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthAccessTokenRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Role
+
+	// no validation rules for EmailVerified
+
+	// no validation rules for ClientId
+
+	if len(errors) > 0 {
+		return AuthAccessTokenResMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthAccessTokenResMultiError is an error wrapping multiple validation errors
+// returned by AuthAccessTokenRes.ValidateAll() if the designated constraints
+// aren't met.
+type AuthAccessTokenResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthAccessTokenResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthAccessTokenResMultiError) AllErrors() []error { return m }
+
+// AuthAccessTokenResValidationError is the validation error returned by
+// AuthAccessTokenRes.Validate if the designated constraints aren't met.
+type AuthAccessTokenResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthAccessTokenResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthAccessTokenResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthAccessTokenResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthAccessTokenResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthAccessTokenResValidationError) ErrorName() string {
+	return "AuthAccessTokenResValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthAccessTokenResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthAccessTokenRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthAccessTokenResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthAccessTokenResValidationError{}
+
+// Validate checks the field values on OAuthUserInfoReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OAuthUserInfoReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OAuthUserInfoReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OAuthUserInfoReqMultiError, or nil if none found.
+func (m *OAuthUserInfoReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OAuthUserInfoReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetToken()); l < 1 || l > 4096 {
+		err := OAuthUserInfoReqValidationError{
+			field:  "Token",
+			reason: "value length must be between 1 and 4096 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OAuthUserInfoReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// OAuthUserInfoReqMultiError is an error wrapping multiple validation errors
+// returned by OAuthUserInfoReq.ValidateAll() if the designated constraints
+// aren't met.
+type OAuthUserInfoReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OAuthUserInfoReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OAuthUserInfoReqMultiError) AllErrors() []error { return m }
+
+// OAuthUserInfoReqValidationError is the validation error returned by
+// OAuthUserInfoReq.Validate if the designated constraints aren't met.
+type OAuthUserInfoReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OAuthUserInfoReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OAuthUserInfoReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OAuthUserInfoReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OAuthUserInfoReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OAuthUserInfoReqValidationError) ErrorName() string { return "OAuthUserInfoReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OAuthUserInfoReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOAuthUserInfoReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OAuthUserInfoReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OAuthUserInfoReqValidationError{}
+
+// Validate checks the field values on OAuthUserInfoRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OAuthUserInfoRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OAuthUserInfoRes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OAuthUserInfoResMultiError, or nil if none found.
+func (m *OAuthUserInfoRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OAuthUserInfoRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sub
+
+	// no validation rules for Name
+
+	// no validation rules for Email
+
+	// no validation rules for EmailVerified
+
+	if len(errors) > 0 {
+		return OAuthUserInfoResMultiError(errors)
+	}
+
+	return nil
+}
+
+// OAuthUserInfoResMultiError is an error wrapping multiple validation errors
+// returned by OAuthUserInfoRes.ValidateAll() if the designated constraints
+// aren't met.
+type OAuthUserInfoResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OAuthUserInfoResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OAuthUserInfoResMultiError) AllErrors() []error { return m }
+
+// OAuthUserInfoResValidationError is the validation error returned by
+// OAuthUserInfoRes.Validate if the designated constraints aren't met.
+type OAuthUserInfoResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OAuthUserInfoResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OAuthUserInfoResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OAuthUserInfoResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OAuthUserInfoResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OAuthUserInfoResValidationError) ErrorName() string { return "OAuthUserInfoResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OAuthUserInfoResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOAuthUserInfoRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OAuthUserInfoResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OAuthUserInfoResValidationError{}
+
+// Validate checks the field values on IntrospectReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IntrospectReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IntrospectReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IntrospectReqMultiError, or
+// nil if none found.
+func (m *IntrospectReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IntrospectReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetToken()); l < 1 || l > 4096 {
+		err := IntrospectReqValidationError{
+			field:  "Token",
+			reason: "value length must be between 1 and 4096 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTokenTypeHint()) > 50 {
+		err := IntrospectReqValidationError{
+			field:  "TokenTypeHint",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetClientId()); err != nil {
+		err = IntrospectReqValidationError{
+			field:  "ClientId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetClientSecret()) > 200 {
+		err := IntrospectReqValidationError{
+			field:  "ClientSecret",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return IntrospectReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *IntrospectReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// IntrospectReqMultiError is an error wrapping multiple validation errors
+// returned by IntrospectReq.ValidateAll() if the designated constraints
+// aren't met.
+type IntrospectReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IntrospectReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IntrospectReqMultiError) AllErrors() []error { return m }
+
+// IntrospectReqValidationError is the validation error returned by
+// IntrospectReq.Validate if the designated constraints aren't met.
+type IntrospectReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IntrospectReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IntrospectReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IntrospectReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IntrospectReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IntrospectReqValidationError) ErrorName() string { return "IntrospectReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IntrospectReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIntrospectReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IntrospectReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IntrospectReqValidationError{}
+
+// Validate checks the field values on IntrospectRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IntrospectRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IntrospectRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IntrospectResMultiError, or
+// nil if none found.
+func (m *IntrospectRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IntrospectRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Active
+
+	// no validation rules for TokenType
+
+	// no validation rules for Sub
+
+	// no validation rules for ClientId
+
+	// no validation rules for Scope
+
+	// no validation rules for Exp
+
+	// no validation rules for Iat
+
+	// no validation rules for Jti
+
+	// no validation rules for Iss
+
+	// no validation rules for Role
+
+	// no validation rules for Act
+
+	if len(errors) > 0 {
+		return IntrospectResMultiError(errors)
+	}
+
+	return nil
+}
+
+// IntrospectResMultiError is an error wrapping multiple validation errors
+// returned by IntrospectRes.ValidateAll() if the designated constraints
+// aren't met.
+type IntrospectResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IntrospectResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IntrospectResMultiError) AllErrors() []error { return m }
+
+// IntrospectResValidationError is the validation error returned by
+// IntrospectRes.Validate if the designated constraints aren't met.
+type IntrospectResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IntrospectResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IntrospectResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IntrospectResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IntrospectResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IntrospectResValidationError) ErrorName() string { return "IntrospectResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IntrospectResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIntrospectRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IntrospectResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IntrospectResValidationError{}
+
+// Validate checks the field values on RevokeTokenReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RevokeTokenReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeTokenReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RevokeTokenReqMultiError,
+// or nil if none found.
+func (m *RevokeTokenReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeTokenReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetToken()); l < 1 || l > 4096 {
+		err := RevokeTokenReqValidationError{
+			field:  "Token",
+			reason: "value length must be between 1 and 4096 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTokenTypeHint()) > 50 {
+		err := RevokeTokenReqValidationError{
+			field:  "TokenTypeHint",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetClientId() != "" {
+
+		if err := m._validateUuid(m.GetClientId()); err != nil {
+			err = RevokeTokenReqValidationError{
+				field:  "ClientId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetClientSecret()) > 200 {
+		err := RevokeTokenReqValidationError{
+			field:  "ClientSecret",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) > 50 {
+		err := RevokeTokenReqValidationError{
+			field:  "Role",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserId() != "" {
+
+		if err := m._validateUuid(m.GetUserId()); err != nil {
+			err = RevokeTokenReqValidationError{
+				field:  "UserId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RevokeTokenReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RevokeTokenReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RevokeTokenReqMultiError is an error wrapping multiple validation errors
+// returned by RevokeTokenReq.ValidateAll() if the designated constraints
+// aren't met.
+type RevokeTokenReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeTokenReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeTokenReqMultiError) AllErrors() []error { return m }
+
+// RevokeTokenReqValidationError is the validation error returned by
+// RevokeTokenReq.Validate if the designated constraints aren't met.
+type RevokeTokenReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeTokenReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeTokenReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeTokenReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeTokenReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeTokenReqValidationError) ErrorName() string { return "RevokeTokenReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RevokeTokenReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeTokenReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeTokenReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeTokenReqValidationError{}
+
+// Validate checks the field values on RevokeTokenRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RevokeTokenRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeTokenRes with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RevokeTokenResMultiError,
+// or nil if none found.
+func (m *RevokeTokenRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeTokenRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeTokenResMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeTokenResMultiError is an error wrapping multiple validation errors
+// returned by RevokeTokenRes.ValidateAll() if the designated constraints
+// aren't met.
+type RevokeTokenResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeTokenResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeTokenResMultiError) AllErrors() []error { return m }
+
+// RevokeTokenResValidationError is the validation error returned by
+// RevokeTokenRes.Validate if the designated constraints aren't met.
+type RevokeTokenResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeTokenResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeTokenResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeTokenResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeTokenResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeTokenResValidationError) ErrorName() string { return "RevokeTokenResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RevokeTokenResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeTokenRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeTokenResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeTokenResValidationError{}
+
+// Validate checks the field values on SSOStartReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SSOStartReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SSOStartReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SSOStartReqMultiError, or
+// nil if none found.
+func (m *SSOStartReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SSOStartReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetProvider()); l < 1 || l > 50 {
+		err := SSOStartReqValidationError{
+			field:  "Provider",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SSOStartReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SSOStartReqMultiError is an error wrapping multiple validation errors
+// returned by SSOStartReq.ValidateAll() if the designated constraints aren't met.
+type SSOStartReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SSOStartReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SSOStartReqMultiError) AllErrors() []error { return m }
+
+// SSOStartReqValidationError is the validation error returned by
+// SSOStartReq.Validate if the designated constraints aren't met.
+type SSOStartReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SSOStartReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SSOStartReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SSOStartReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SSOStartReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SSOStartReqValidationError) ErrorName() string { return "SSOStartReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SSOStartReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSSOStartReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SSOStartReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SSOStartReqValidationError{}
+
+// Validate checks the field values on SSOStartRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SSOStartRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SSOStartRes with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SSOStartResMultiError, or
+// nil if none found.
+func (m *SSOStartRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SSOStartRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AuthUrl
+
+	// no validation rules for State
+
+	if len(errors) > 0 {
+		return SSOStartResMultiError(errors)
+	}
+
+	return nil
+}
+
+// SSOStartResMultiError is an error wrapping multiple validation errors
+// returned by SSOStartRes.ValidateAll() if the designated constraints aren't met.
+type SSOStartResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SSOStartResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SSOStartResMultiError) AllErrors() []error { return m }
+
+// SSOStartResValidationError is the validation error returned by
+// SSOStartRes.Validate if the designated constraints aren't met.
+type SSOStartResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SSOStartResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SSOStartResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SSOStartResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SSOStartResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SSOStartResValidationError) ErrorName() string { return "SSOStartResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SSOStartResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSSOStartRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SSOStartResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SSOStartResValidationError{}
+
+// Validate checks the field values on SSOCallbackReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SSOCallbackReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SSOCallbackReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SSOCallbackReqMultiError,
+// or nil if none found.
+func (m *SSOCallbackReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SSOCallbackReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetProvider()); l < 1 || l > 50 {
+		err := SSOCallbackReqValidationError{
+			field:  "Provider",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 2000 {
+		err := SSOCallbackReqValidationError{
+			field:  "Code",
+			reason: "value length must be between 1 and 2000 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetState()); err != nil {
+		err = SSOCallbackReqValidationError{
+			field:  "State",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SSOCallbackReqMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SSOCallbackReq) _validateUuid(uuid string) error {
+	if matched := _user_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SSOCallbackReqMultiError is an error wrapping multiple validation errors
+// returned by SSOCallbackReq.ValidateAll() if the designated constraints
+// aren't met.
+type SSOCallbackReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SSOCallbackReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SSOCallbackReqMultiError) AllErrors() []error { return m }
+
+// SSOCallbackReqValidationError is the validation error returned by
+// SSOCallbackReq.Validate if the designated constraints aren't met.
+type SSOCallbackReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SSOCallbackReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SSOCallbackReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SSOCallbackReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SSOCallbackReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SSOCallbackReqValidationError) ErrorName() string { return "SSOCallbackReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SSOCallbackReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSSOCallbackReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SSOCallbackReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SSOCallbackReqValidationError{}
+
+// Validate checks the field values on JWKSReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JWKSReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JWKSReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in JWKSReqMultiError, or nil if none found.
+func (m *JWKSReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JWKSReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return JWKSReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// JWKSReqMultiError is an error wrapping multiple validation errors returned
+// by JWKSReq.ValidateAll() if the designated constraints aren't met.
+type JWKSReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JWKSReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JWKSReqMultiError) AllErrors() []error { return m }
+
+// JWKSReqValidationError is the validation error returned by JWKSReq.Validate
+// if the designated constraints aren't met.
+type JWKSReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JWKSReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JWKSReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JWKSReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JWKSReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JWKSReqValidationError) ErrorName() string { return "JWKSReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JWKSReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJWKSReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JWKSReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JWKSReqValidationError{}
+
+// Validate checks the field values on JWKSRes with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JWKSRes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JWKSRes with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in JWKSResMultiError, or nil if none found.
+func (m *JWKSRes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JWKSRes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Jwks
+
+	if len(errors) > 0 {
+		return JWKSResMultiError(errors)
+	}
+
+	return nil
+}
+
+// JWKSResMultiError is an error wrapping multiple validation errors returned
+// by JWKSRes.ValidateAll() if the designated constraints aren't met.
+type JWKSResMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JWKSResMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JWKSResMultiError) AllErrors() []error { return m }
+
+// JWKSResValidationError is the validation error returned by JWKSRes.Validate
+// if the designated constraints aren't met.
+type JWKSResValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JWKSResValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JWKSResValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JWKSResValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JWKSResValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JWKSResValidationError) ErrorName() string { return "JWKSResValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JWKSResValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJWKSRes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JWKSResValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JWKSResValidationError{}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"users/internal/crypto"
+	"users/internal/db"
+
+	"github.com/Votline/3l1/protos/apierr"
+	pb "github.com/Votline/3l1/protos/generated-user"
+)
+
+const defaultKeyRateLimit = 60
+
+func (us *userserver) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyReq) (*pb.CreateAPIKeyRes, error) {
+	const op = "UserService.CreateAPIKey"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	perms, err := us.permsOf(req.GetRole())
+	if err != nil {
+		return nil, fmt.Errorf("%s: get permissions: %w", op, err)
+	}
+	// a key can never do more than its owner
+	for _, s := range req.GetScopes() {
+		if !perms.Has(s) {
+			return nil, fmt.Errorf("%s: check scopes: %w", op, apierr.PermissionDenied("Scope not granted to user: "+s))
+		}
+	}
+
+	key := &db.APIKey{
+		ID:        uuid.New().String(),
+		UserID:    req.GetUserId(),
+		Name:      req.GetName(),
+		Scopes:    req.GetScopes(),
+		RateLimit: req.GetRateLimit(),
+	}
+	if key.RateLimit == 0 {
+		key.RateLimit = defaultKeyRateLimit
+	}
+
+	full, secret, err := crypto.GenAPIKey(key.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: generate key: %w", op, err)
+	}
+
+	if err := us.repo.AddAPIKey(key, crypto.HashCode(secret)); err != nil {
+		return nil, fmt.Errorf("%s: add key: %w", op, err)
+	}
+
+	return &pb.CreateAPIKeyRes{Key: toAPIKey(key), Secret: full}, nil
+}
+
+func (us *userserver) ListAPIKeys(ctx context.Context, req *pb.ListAPIKeysReq) (*pb.ListAPIKeysRes, error) {
+	const op = "UserService.ListAPIKeys"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	keys, err := us.repo.ListAPIKeys(req.GetUserId())
+	if err != nil {
+		return nil, fmt.Errorf("%s: list keys: %w", op, err)
+	}
+
+	res := &pb.ListAPIKeysRes{Keys: make([]*pb.APIKey, len(keys))}
+	for i := range keys {
+		res.Keys[i] = toAPIKey(&keys[i])
+	}
+
+	return res, nil
+}
+
+func (us *userserver) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyReq) (*pb.RevokeAPIKeyRes, error) {
+	const op = "UserService.RevokeAPIKey"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	if err := us.repo.RevokeAPIKey(req.GetUserId(), req.GetKeyId()); err != nil {
+		return nil, fmt.Errorf("%s: revoke key: %w", op, err)
+	}
+
+	return &pb.RevokeAPIKeyRes{}, nil
+}
+
+func (us *userserver) AuthAPIKey(ctx context.Context, req *pb.AuthAPIKeyReq) (*pb.AuthAPIKeyRes, error) {
+	const op = "UserService.AuthAPIKey"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	id, secret, err := crypto.ParseAPIKey(req.GetKey())
+	if err != nil {
+		return nil, fmt.Errorf("%s: parse key: %v: %w", op, err, apierr.InvalidCredentials("Invalid API key"))
+	}
+
+	data, err := us.repo.GetKeyOwner(id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: get key: %w", op, apierr.InvalidCredentials("Invalid API key"))
+	}
+	if subtle.ConstantTimeCompare([]byte(data.KeyHash), []byte(crypto.HashCode(secret))) != 1 {
+		return nil, fmt.Errorf("%s: check key: %w", op, apierr.InvalidCredentials("Invalid API key"))
+	}
+
+	// the owner's role may have shrunk since the key was made
+	perms, err := us.permsOf(data.Role)
+	if err != nil {
+		return nil, fmt.Errorf("%s: get permissions: %w", op, err)
+	}
+	var granted []string
+	for _, s := range data.Scopes {
+		if perms.Has(s) {
+			granted = append(granted, s)
+		}
+	}
+
+	if err := us.repo.TouchAPIKey(id); err != nil {
+		us.log.Error("Failed to update key last use",
+			zap.String("op", op),
+			zap.String("key id", id),
+			zap.Error(err))
+	}
+
+	return &pb.AuthAPIKeyRes{
+		KeyId:         data.ID,
+		UserId:        data.UserID,
+		Role:          data.Role,
+		EmailVerified: data.Verified,
+		Permissions:   granted,
+		RateLimit:     data.RateLimit,
+	}, nil
+}
+
+func toAPIKey(k *db.APIKey) *pb.APIKey {
+	res := &pb.APIKey{
+		Id:        k.ID,
+		Name:      k.Name,
+		Scopes:    k.Scopes,
+		RateLimit: k.RateLimit,
+		CreatedAt: timestamppb.New(k.CreatedAt),
+	}
+	if k.LastUsedAt.Valid {
+		res.LastUsedAt = timestamppb.New(k.LastUsedAt.Time)
+	}
+	return res
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"users/internal/db"
+	"users/internal/metrics"
+
+	"github.com/Votline/3l1/protos/apierr"
+	"github.com/Votline/3l1/protos/authz"
+	pbo "github.com/Votline/3l1/protos/generated-order"
+	pb "github.com/Votline/3l1/protos/generated-user"
+)
+
+const sagaBatch = 20
+
+// runDeletions drives user deletion sagas until ctx is done. DelUser
+// only marks the user; here order-service applies the UserDeleted event,
+// the row is removed and order-service drops its undo data.
+func (us *userserver) runDeletions(ctx context.Context) {
+	ticker := time.NewTicker(us.sagas.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		sagas, err := us.repo.ClaimDeletions(sagaBatch, us.sagas.Lease)
+		if err != nil {
+			us.log.Error("Failed to claim deletion sagas", zap.Error(err))
+			continue
+		}
+		for i := range sagas {
+			us.stepDeletion(ctx, &sagas[i])
+		}
+	}
+}
+
+// stepDeletion runs the saga as far as it gets and saves it after every
+// step, so a crash repeats at most one idempotent step.
+func (us *userserver) stepDeletion(ctx context.Context, d *db.Deletion) {
+	const op = "UserService.stepDeletion"
+
+	for ctx.Err() == nil {
+		next, err := us.deletionStep(ctx, d)
+		if next == "" {
+			return
+		}
+
+		if err != nil {
+			us.failDeletion(d, err)
+			us.log.Warn("Deletion saga step failed",
+				zap.String("op", op),
+				zap.String("saga id", d.ID),
+				zap.String("state", d.State),
+				zap.Int("attempts", d.Attempts),
+				zap.Error(err))
+		} else {
+			d.State, d.Attempts, d.LastError = next, 0, ""
+			d.NextAttemptAt = time.Now()
+			metrics.DeletionSagas.WithLabelValues(next).Inc()
+		}
+
+		if err := us.repo.SaveDeletion(d); err != nil {
+			us.log.Error("Failed to save deletion saga",
+				zap.String("op", op),
+				zap.String("saga id", d.ID),
+				zap.Error(err))
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// deletionStep performs the work of the current state and returns the
+// state to move to, or "" for a finished saga.
+func (us *userserver) deletionStep(ctx context.Context, d *db.Deletion) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req := &pbo.UserDeletionReq{SagaId: d.ID, UserId: d.UserID}
+	switch d.State {
+	case db.DeletionPending:
+		res, err := us.orders.UserDeleted(ctx, req)
+		if err != nil {
+			return d.State, fmt.Errorf("apply in order-service: %w", err)
+		}
+		d.OrdersCancelled, d.OrdersAnonymised = res.GetCancelled(), res.GetAnonymised()
+		return db.DeletionOrdersDone, nil
+
+	case db.DeletionOrdersDone:
+		if err := us.repo.PurgeUser(d.UserID); err != nil {
+			return d.State, fmt.Errorf("purge user: %w", err)
+		}
+		return db.DeletionUserDeleted, nil
+
+	case db.DeletionUserDeleted:
+		if _, err := us.orders.FinishUserDeletion(ctx, req); err != nil {
+			return d.State, fmt.Errorf("finish in order-service: %w", err)
+		}
+		return db.DeletionCompleted, nil
+
+	case db.DeletionCompensating:
+		if _, err := us.orders.CompensateUserDeletion(ctx, req); err != nil {
+			return d.State, fmt.Errorf("compensate in order-service: %w", err)
+		}
+		if err := us.repo.RestoreUser(d.UserID); err != nil {
+			return d.State, fmt.Errorf("restore user: %w", err)
+		}
+		return db.DeletionCompensated, nil
+	}
+
+	return "", nil
+}
+
+func (us *userserver) failDeletion(d *db.Deletion, err error) {
+	d.Attempts++
+	d.LastError = err.Error()
+	metrics.DeletionSagas.WithLabelValues("failed").Inc()
+
+	// only a saga that has not touched the orders yet may give up
+	if d.State == db.DeletionPending && d.Attempts >= us.sagas.MaxAttempts {
+		us.log.Error("Deletion saga gave up, compensating",
+			zap.String("saga id", d.ID),
+			zap.String("user id", d.UserID),
+			zap.Error(err))
+		d.State, d.Attempts = db.DeletionCompensating, 0
+		d.NextAttemptAt = time.Now()
+		return
+	}
+	d.NextAttemptAt = time.Now().Add(us.sagas.Backoff(d.Attempts))
+}
+
+func (us *userserver) ListDeletions(ctx context.Context, req *pb.ListDeletionsReq) (*pb.ListDeletionsRes, error) {
+	const op = "UserService.ListDeletions"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	perms := authz.New(req.GetPermissions())
+	if !perms.Has(authz.UsersDeleteAny) {
+		return nil, fmt.Errorf("%s: check permission: %w", op, apierr.PermissionDenied("Not allowed to inspect deletions"))
+	}
+
+	limit := uint64(req.GetLimit())
+	if limit == 0 {
+		limit = 50
+	}
+
+	sagas, err := us.repo.ListDeletions(req.GetId(), req.GetAll(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: list deletions: %w", op, err)
+	}
+
+	res := &pb.ListDeletionsRes{Deletions: make([]*pb.Deletion, 0, len(sagas))}
+	for _, d := range sagas {
+		res.Deletions = append(res.Deletions, &pb.Deletion{
+			Id:               d.ID,
+			UserId:           d.UserID,
+			RequestedBy:      d.RequestedBy,
+			State:            d.State,
+			Attempts:         int32(d.Attempts),
+			LastError:        d.LastError,
+			OrdersCancelled:  d.OrdersCancelled,
+			OrdersAnonymised: d.OrdersAnonymised,
+			NextAttemptAt:    timestamppb.New(d.NextAttemptAt),
+			CreatedAt:        timestamppb.New(d.CreatedAt),
+			UpdatedAt:        timestamppb.New(d.UpdatedAt),
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,246 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"users/internal/crypto"
+	"users/internal/db"
+
+	"github.com/Votline/3l1/protos/apierr"
+	"github.com/Votline/3l1/protos/authz"
+	pbo "github.com/Votline/3l1/protos/generated-order"
+	pb "github.com/Votline/3l1/protos/generated-user"
+)
+
+// receipts outlive the account by far, they are the proof of erasure
+const erasureReceiptTTL = 5 * 365 * 24 * time.Hour
+
+func (us *userserver) ExportUserData(ctx context.Context, req *pb.ExportUserDataReq) (*pb.ExportUserDataRes, error) {
+	const op = "UserService.ExportUserData"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	targetID := req.GetTargetId()
+	perms, err := us.permsOf(req.GetRole())
+	if err != nil {
+		return nil, fmt.Errorf("%s: get permissions: %w", op, err)
+	}
+	if !perms.CanOn(authz.UsersRead, req.GetUserId() == targetID) {
+		return nil, fmt.Errorf("%s: check permission: %w", op, apierr.PermissionDenied("Not allowed to export this user"))
+	}
+
+	profile, err := us.repo.GetUser(targetID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: get user: %w", op, err)
+	}
+
+	sessions, err := us.redisRepo.ListSessions(targetID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: list sessions: %w", op, err)
+	}
+
+	keys, err := us.repo.ListAPIKeys(targetID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: list api keys: %w", op, err)
+	}
+	keysRes := &pb.ListAPIKeysRes{}
+	for i := range keys {
+		keysRes.Keys = append(keysRes.Keys, toAPIKey(&keys[i]))
+	}
+
+	orders, err := us.orders.UserOrders(ctx, &pbo.UserOrdersReq{UserId: targetID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: get orders: %w", op, err)
+	}
+
+	now := time.Now().UTC()
+	files := []struct {
+		name string
+		data func() ([]byte, error)
+	}{
+		{"profile.json", func() ([]byte, error) { return protojson.Marshal(toProfile(profile)) }},
+		{"sessions.json", func() ([]byte, error) { return json.Marshal(sessions) }},
+		{"api_keys.json", func() ([]byte, error) { return protojson.Marshal(keysRes) }},
+		{"orders.json", func() ([]byte, error) { return protojson.Marshal(orders) }},
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	var names []string
+	for _, f := range files {
+		data, err := f.data()
+		if err != nil {
+			return nil, fmt.Errorf("%s: marshal %s: %w", op, f.name, err)
+		}
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: f.name, Method: zip.Deflate, Modified: now})
+		if err != nil {
+			return nil, fmt.Errorf("%s: add %s: %w", op, f.name, err)
+		}
+		if _, err := w.Write(data); err != nil {
+			return nil, fmt.Errorf("%s: write %s: %w", op, f.name, err)
+		}
+		names = append(names, f.name)
+	}
+
+	data, err := json.Marshal(map[string]any{
+		"user_id":      targetID,
+		"generated_at": now,
+		"files":        names,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: marshal manifest: %w", op, err)
+	}
+	w, err := zw.CreateHeader(&zip.FileHeader{Name: "manifest.json", Method: zip.Deflate, Modified: now})
+	if err != nil {
+		return nil, fmt.Errorf("%s: add manifest: %w", op, err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return nil, fmt.Errorf("%s: write manifest: %w", op, err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("%s: close archive: %w", op, err)
+	}
+
+	us.log.Info("Exported user data",
+		zap.String("op", op),
+		zap.String("actor id", req.GetUserId()),
+		zap.String("user id", targetID))
+
+	return &pb.ExportUserDataRes{
+		Archive:  buf.Bytes(),
+		Filename: fmt.Sprintf("user-%s-%s.zip", targetID, now.Format("20060102")),
+	}, nil
+}
+
+// EraseUser removes the user from order-service, redis and postgres in
+// that order. Every step is idempotent, so a failed erasure is retried
+// by calling it again; the account row goes last and with it the
+// record is written.
+func (us *userserver) EraseUser(ctx context.Context, req *pb.EraseUserReq) (*pb.EraseUserRes, error) {
+	const op = "UserService.EraseUser"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	userID := req.GetUserId()
+	targetID := req.GetTargetId()
+	own := userID == targetID
+	perms, err := us.permsOf(req.GetRole())
+	if err != nil {
+		return nil, fmt.Errorf("%s: get permissions: %w", op, err)
+	}
+	if !perms.CanOn(authz.UsersDelete, own) {
+		return nil, fmt.Errorf("%s: check permission: %w", op, apierr.PermissionDenied("Not allowed to erase this user"))
+	}
+
+	target, err := us.repo.GetUser(targetID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: get user: %w", op, err)
+	}
+	if !own {
+		// peers holding the same power cannot erase each other
+		targetPerms, err := us.permsOf(target.Role)
+		if err != nil {
+			return nil, fmt.Errorf("%s: get target permissions: %w", op, err)
+		}
+		if targetPerms.Has(authz.UsersDeleteAny) {
+			return nil, fmt.Errorf("%s: check role: %w", op, apierr.PermissionDenied("Cannot erase a user with the same privileges"))
+		}
+	}
+
+	orders, err := us.orders.EraseUserOrders(ctx, &pbo.EraseUserOrdersReq{UserId: targetID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: erase orders: %w", op, err)
+	}
+
+	sessions, err := us.redisRepo.ListSessions(targetID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: list sessions: %w", op, err)
+	}
+	if err := us.redisRepo.DelUserSessions(targetID); err != nil {
+		return nil, fmt.Errorf("%s: delete sessions: %w", op, err)
+	}
+	if err := us.redisRepo.ResetLogin(targetID); err != nil {
+		return nil, fmt.Errorf("%s: reset login: %w", op, err)
+	}
+
+	rec := &db.ErasureRecord{
+		ID:          uuid.NewString(),
+		SubjectHash: crypto.HashCode(targetID),
+		RequestedBy: userID,
+		Steps: map[string]int64{
+			"orders":   orders.GetErased(),
+			"sessions": int64(len(sessions)),
+			"account":  1,
+		},
+		CompletedAt: time.Now().UTC(),
+	}
+	if own {
+		// the requester's id is what is being erased
+		rec.RequestedBy = "self"
+	}
+
+	rec.Receipt, err = us.signer.Sign(jwt.MapClaims{
+		"jti":          rec.ID,
+		"typ":          "erasure",
+		"subject_hash": rec.SubjectHash,
+		"requested_by": rec.RequestedBy,
+		"steps":        rec.Steps,
+		"completed_at": rec.CompletedAt.Unix(),
+	}, erasureReceiptTTL)
+	if err != nil {
+		return nil, fmt.Errorf("%s: sign receipt: %w", op, err)
+	}
+
+	if err := us.repo.EraseUser(targetID, rec); err != nil {
+		return nil, fmt.Errorf("%s: erase user: %w", op, err)
+	}
+
+	us.log.Info("Erased user",
+		zap.String("op", op),
+		zap.String("record id", rec.ID),
+		zap.String("requested by", rec.RequestedBy),
+		zap.Int64("orders", orders.GetErased()))
+
+	return &pb.EraseUserRes{Record: toErasure(rec)}, nil
+}
+
+func (us *userserver) GetErasure(ctx context.Context, req *pb.GetErasureReq) (*pb.GetErasureRes, error) {
+	const op = "UserService.GetErasure"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	rec, err := us.repo.GetErasure(req.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("%s: get record: %w", op, err)
+	}
+
+	return &pb.GetErasureRes{Record: toErasure(rec)}, nil
+}
+
+func toErasure(rec *db.ErasureRecord) *pb.ErasureRecord {
+	return &pb.ErasureRecord{
+		Id:          rec.ID,
+		SubjectHash: rec.SubjectHash,
+		RequestedBy: rec.RequestedBy,
+		Steps:       rec.Steps,
+		CompletedAt: timestamppb.New(rec.CompletedAt),
+		Receipt:     rec.Receipt,
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"users/internal/crypto"
+	"users/internal/db"
+
+	"github.com/Votline/3l1/protos/apierr"
+	"github.com/Votline/3l1/protos/authz"
+	pb "github.com/Votline/3l1/protos/generated-user"
+)
+
+func (us *userserver) Impersonate(ctx context.Context, req *pb.ImpersonateReq) (*pb.ImpersonateRes, error) {
+	const op = "UserService.Impersonate"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	actorID := req.GetUserId()
+	targetID := req.GetTargetId()
+	perms := authz.New(req.GetPermissions())
+	if !perms.Has(authz.UsersImpersonate) {
+		return nil, fmt.Errorf("%s: check permission: %w", op, apierr.PermissionDenied("Not allowed to impersonate users"))
+	}
+	if actorID == targetID {
+		return nil, fmt.Errorf("%s: match id's: %w", op, apierr.PermissionDenied("Cannot impersonate yourself"))
+	}
+
+	role, err := us.repo.Impersonate(actorID, targetID, req.GetReason())
+	if err != nil {
+		return nil, fmt.Errorf("%s: impersonate: %w", op, err)
+	}
+
+	verified, err := us.repo.IsVerified(targetID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: check verified: %w", op, err)
+	}
+
+	targetPerms, err := us.repo.RolePermissions(role)
+	if err != nil {
+		return nil, fmt.Errorf("%s: get target permissions: %w", op, err)
+	}
+
+	sessionKey, err := us.redisRepo.NewImpersonation(targetID, role, actorID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: new session: %w", op, err)
+	}
+
+	token, err := crypto.GenJWT(crypto.UserInfo{
+		UserID:   targetID,
+		Role:     role,
+		Verified: verified,
+		Perms:    targetPerms,
+		Actor:    actorID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: generate jwt: %w", op, err)
+	}
+
+	us.log.Info("Impersonation started",
+		zap.String("op", op),
+		zap.String("actor id", actorID),
+		zap.String("target id", targetID))
+
+	return &pb.ImpersonateRes{
+		Token:      token,
+		SessionKey: sessionKey,
+		ExpiresIn:  int64(db.ImpersonationTTL.Seconds()),
+	}, nil
+}
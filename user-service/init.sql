@@ -2,8 +2,238 @@ CREATE TABLE IF NOT EXISTS users (
 	id   TEXT PRIMARY KEY,
 	role TEXT NOT NULL,
 	pswd TEXT NOT NULL,
-	user_name TEXT NOT NULL UNIQUE
+	name TEXT NOT NULL CONSTRAINT users_name_key UNIQUE,
+	email TEXT CONSTRAINT users_email_key UNIQUE,
+	email_verified BOOLEAN NOT NULL DEFAULT FALSE,
+	totp_secret TEXT,
+	totp_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+	display_name TEXT NOT NULL DEFAULT '',
+	bio TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+	deleted_at TIMESTAMP,
+	legacy_name BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+-- Accounts made before verification existed were never asked to
+-- verify, so they count as verified; only the new ones start unverified.
+DO $$
+BEGIN
+	IF NOT EXISTS (SELECT 1 FROM information_schema.columns
+		WHERE table_name = 'users' AND column_name = 'email_verified') THEN
+		ALTER TABLE users ADD COLUMN email_verified BOOLEAN NOT NULL DEFAULT FALSE;
+		UPDATE users SET email_verified = TRUE;
+	END IF;
+END $$;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS totp_secret TEXT;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS totp_enabled BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS display_name TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS bio TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS created_at TIMESTAMP NOT NULL DEFAULT NOW();
+ALTER TABLE users ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP NOT NULL DEFAULT NOW();
+-- set while a deletion saga runs, the row goes once order-service is done
+ALTER TABLE users ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+-- set on rows whose name still holds the legacy name and email, see below
+ALTER TABLE users ADD COLUMN IF NOT EXISTS legacy_name BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Legacy rows stored name and email concatenated in user_name. Where one
+-- ends and the other starts cannot be told from the value ("alicebob@x.io"
+-- may be alice + bob@x.io or alicebob + nothing), so it is not split here.
+-- The whole value moves to name with a NULL email and the row is flagged
+-- legacy_name. Until its owner logs in it can be found with
+-- SELECT id, name FROM users WHERE legacy_name. The owner can log in with
+-- the whole value as name, or with name and email as they registered;
+-- the latter, after the password checks out, is the split that is stored.
+DO $$
+BEGIN
+	IF EXISTS (SELECT 1 FROM information_schema.columns
+		WHERE table_name = 'users' AND column_name = 'user_name') THEN
+		DROP INDEX IF EXISTS idx_user_name;
+		ALTER TABLE users RENAME COLUMN user_name TO name;
+		ALTER TABLE users RENAME CONSTRAINT users_user_name_key TO users_name_key;
+		ALTER TABLE users ADD COLUMN email TEXT CONSTRAINT users_email_key UNIQUE;
+		UPDATE users SET legacy_name = TRUE;
+	END IF;
+END $$;
+
+CREATE TABLE IF NOT EXISTS recovery_codes (
+	user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	code_hash TEXT NOT NULL,
+	used_at TIMESTAMP,
+	PRIMARY KEY (user_id, code_hash)
+);
+
+CREATE TABLE IF NOT EXISTS mfa_roles (
+	role TEXT PRIMARY KEY
+);
+
+CREATE TABLE IF NOT EXISTS permissions (
+	name TEXT PRIMARY KEY,
+	description TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+	role TEXT NOT NULL,
+	permission TEXT NOT NULL REFERENCES permissions(name) ON DELETE CASCADE,
+	PRIMARY KEY (role, permission)
+);
+
+INSERT INTO permissions (name, description) VALUES
+	('users:read:own', 'View own profile'),
+	('users:read:any', 'View any profile'),
+	('users:list', 'Search the user directory'),
+	('users:delete:own', 'Delete own account'),
+	('users:delete:any', 'Delete other accounts'),
+	('users:role:set', 'Assign roles'),
+	('users:unlock', 'Clear login lockouts'),
+	('users:mfa:manage', 'Change MFA policy'),
+	('users:impersonate', 'Act as another user'),
+	('users:invite', 'Manage invite codes'),
+	('orders:create', 'Create orders'),
+	('orders:read:own', 'View own orders'),
+	('orders:read:any', 'View any order'),
+	('orders:delete:own', 'Delete own orders'),
+	('orders:delete:any', 'Delete other users'' orders'),
+	('tokens:revoke:own', 'Revoke own tokens'),
+	('tokens:revoke:any', 'Revoke any token'),
+	('gateway:manage', 'Operate gateway circuit breakers')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES
+	('guest', 'users:read:own'),
+	('guest', 'users:delete:own'),
+	('guest', 'orders:create'),
+	('guest', 'orders:read:own'),
+	('guest', 'orders:delete:own'),
+	('guest', 'tokens:revoke:own'),
+	('dev', 'users:read:own'),
+	('dev', 'users:delete:own'),
+	('dev', 'orders:create'),
+	('dev', 'orders:read:own'),
+	('dev', 'orders:delete:own'),
+	('dev', 'tokens:revoke:own'),
+	('admin', 'users:read:any'),
+	('admin', 'users:list'),
+	('admin', 'users:delete:any'),
+	('admin', 'users:role:set'),
+	('admin', 'users:unlock'),
+	('admin', 'users:mfa:manage'),
+	('admin', 'users:impersonate'),
+	('admin', 'users:invite'),
+	('admin', 'orders:create'),
+	('admin', 'orders:read:any'),
+	('admin', 'orders:delete:any'),
+	('admin', 'tokens:revoke:any'),
+	('admin', 'gateway:manage')
+ON CONFLICT DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS audit_log (
+	id BIGSERIAL PRIMARY KEY,
+	actor_id TEXT NOT NULL,
+	action TEXT NOT NULL,
+	target_id TEXT NOT NULL,
+	details JSONB NOT NULL DEFAULT '{}',
+	created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_target ON audit_log(target_id, created_at);
+
+CREATE TABLE IF NOT EXISTS api_keys (
+	id TEXT PRIMARY KEY,
+	user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	key_hash TEXT NOT NULL,
+	scopes TEXT[] NOT NULL,
+	rate_limit INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+	last_used_at TIMESTAMP,
+	revoked_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_user ON api_keys(user_id) WHERE revoked_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS oauth_clients (
+	id TEXT PRIMARY KEY,
+	owner_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	secret_hash TEXT,
+	redirect_uris TEXT[] NOT NULL,
+	scopes TEXT[] NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_oauth_clients_owner ON oauth_clients(owner_id);
+
+CREATE TABLE IF NOT EXISTS oauth_consents (
+	user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	client_id TEXT NOT NULL REFERENCES oauth_clients(id) ON DELETE CASCADE,
+	scopes TEXT[] NOT NULL,
+	granted_at TIMESTAMP NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (user_id, client_id)
+);
+
+CREATE TABLE IF NOT EXISTS federated_identities (
+	provider TEXT NOT NULL,
+	subject TEXT NOT NULL,
+	user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	email TEXT,
+	created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (provider, subject)
+);
+
+CREATE INDEX IF NOT EXISTS idx_federated_user ON federated_identities(user_id);
+
+-- proof that an account was erased; the user id is kept only as a hash
+CREATE TABLE IF NOT EXISTS erasure_records (
+	id TEXT PRIMARY KEY,
+	subject_hash TEXT NOT NULL,
+	requested_by TEXT NOT NULL,
+	steps JSONB NOT NULL,
+	completed_at TIMESTAMP NOT NULL,
+	receipt TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_erasure_subject ON erasure_records(subject_hash);
+
+CREATE TABLE IF NOT EXISTS deletion_sagas (
+	id TEXT PRIMARY KEY,
+	user_id TEXT NOT NULL,
+	requested_by TEXT NOT NULL,
+	state TEXT NOT NULL DEFAULT 'pending',
+	attempts INTEGER NOT NULL DEFAULT 0,
+	last_error TEXT NOT NULL DEFAULT '',
+	orders_cancelled BIGINT NOT NULL DEFAULT 0,
+	orders_anonymised BIGINT NOT NULL DEFAULT 0,
+	next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+	created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_deletion_sagas_due ON deletion_sagas(next_attempt_at)
+	WHERE state NOT IN ('completed', 'compensated');
+
+CREATE TABLE IF NOT EXISTS invite_codes (
+	id TEXT PRIMARY KEY,
+	code_hash TEXT NOT NULL UNIQUE,
+	role TEXT NOT NULL,
+	note TEXT NOT NULL DEFAULT '',
+	max_uses INTEGER NOT NULL,
+	uses INTEGER NOT NULL DEFAULT 0,
+	expires_at TIMESTAMP NOT NULL,
+	created_by TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+	revoked_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS invite_redemptions (
+	invite_id TEXT NOT NULL REFERENCES invite_codes(id) ON DELETE CASCADE,
+	user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	redeemed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (invite_id, user_id)
 );
 
 CREATE INDEX IF NOT EXISTS idx_id ON users(id);
-CREATE INDEX IF NOT EXISTS idx_user_name ON users(user_name);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email_lower ON users(lower(email));
+CREATE INDEX IF NOT EXISTS idx_users_name_prefix ON users(lower(name) text_pattern_ops);
+CREATE INDEX IF NOT EXISTS idx_users_email_prefix ON users(lower(email) text_pattern_ops);
+CREATE INDEX IF NOT EXISTS idx_users_created_at ON users(created_at, id);
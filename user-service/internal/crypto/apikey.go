@@ -0,0 +1,41 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+const apiKeyPrefix = "3l1_"
+
+// GenAPIKey returns the full key handed to the client and the secret
+// part to be hashed. The key id travels in the clear for lookup.
+func GenAPIKey(id string) (string, string, error) {
+	secret, err := GenSecret()
+	if err != nil {
+		return "", "", err
+	}
+	return apiKeyPrefix + id + "_" + secret, secret, nil
+}
+
+// GenSecret returns 256 random bits as hex.
+func GenSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func ParseAPIKey(key string) (string, string, error) {
+	rest, ok := strings.CutPrefix(key, apiKeyPrefix)
+	if !ok {
+		return "", "", errors.New("unknown key format")
+	}
+	id, secret, ok := strings.Cut(rest, "_")
+	if !ok || id == "" || secret == "" {
+		return "", "", errors.New("malformed key")
+	}
+	return id, secret, nil
+}
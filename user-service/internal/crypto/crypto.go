@@ -2,41 +2,63 @@ package crypto
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
-	"golang.org/x/crypto/bcrypt"
-)
+	"github.com/google/uuid"
 
-func Hash(source string) (string, error) {
-	bytes, err := bcrypt.GenerateFromPassword(
-		[]byte(source), bcrypt.DefaultCost)
-	if err != nil {
-		return "", err
-	}
-	return string(bytes), nil
-}
-func CheckPswd(hash, password string) bool {
-	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
-	return err == nil
-}
+	"github.com/Votline/3l1/protos/apierr"
+)
 
 type UserInfo struct {
-	Role   string
-	UserID string
+	Role     string
+	UserID   string
+	Verified bool
+	Perms    []string
+	// Actor is the admin acting as UserID, empty outside impersonation
+	Actor string
+	// ID is the jti, every issued token gets a fresh one
+	ID        string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
 }
 
-func GenJWT(userID, role string) (string, error) {
+func GenJWT(info UserInfo) (string, error) {
+	now := time.Now()
 	claims := jwt.MapClaims{
-		"role":    role,
-		"user_id": userID,
-		"exp":     time.Now().Add(15 * time.Minute).Unix(),
+		"role":           info.Role,
+		"user_id":        info.UserID,
+		"email_verified": info.Verified,
+		"perms":          info.Perms,
+		"jti":            uuid.NewString(),
+		"iat":            now.Unix(),
+		"exp":            now.Add(15 * time.Minute).Unix(),
+	}
+	if info.Actor != "" {
+		claims["act"] = map[string]string{"sub": info.Actor}
 	}
 
 	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(os.Getenv("JWT_SECRET")))
 }
+
+// ErrInvalidToken is what callers see for a session token that does
+// not verify or has expired, the cause is wrapped for the logs.
+var ErrInvalidToken = apierr.InvalidCredentials("Invalid or expired token")
+
+// ExtJWT verifies the signature and expiry. A token that fails either
+// is ErrInvalidToken; the claims read so far come back with it, for the
+// logs only, callers must not trust them.
 func ExtJWT(tokenString string) (UserInfo, error) {
+	info, err := extJWT(tokenString)
+	if err != nil {
+		return info, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	return info, nil
+}
+
+func extJWT(tokenString string) (UserInfo, error) {
 	parser := jwt.NewParser(jwt.WithoutClaimsValidation())
 	token, err := parser.Parse(tokenString,
 		func(token *jwt.Token) (any, error) {
@@ -68,9 +90,27 @@ func ExtJWT(tokenString string) (UserInfo, error) {
 		info.Role = role
 	}
 
+	// tokens issued before email verification existed have no claim
+	info.Verified, _ = claims["email_verified"].(bool)
+	if perms, ok := claims["perms"].([]any); ok {
+		for _, p := range perms {
+			if s, ok := p.(string); ok {
+				info.Perms = append(info.Perms, s)
+			}
+		}
+	}
+	if act, ok := claims["act"].(map[string]any); ok {
+		info.Actor, _ = act["sub"].(string)
+	}
+	// tokens issued before revocation existed have neither
+	info.ID, _ = claims["jti"].(string)
+	if iat, ok := claims["iat"].(float64); ok {
+		info.IssuedAt = time.Unix(int64(iat), 0)
+	}
+
 	if exp, ok := claims["exp"].(float64); !ok {
 		return UserInfo{}, errors.New("Failed to extract exp from JWT token")
-	} else if time.Now().Unix() > int64(exp) {
+	} else if info.ExpiresAt = time.Unix(int64(exp), 0); time.Now().Unix() > int64(exp) {
 		return info, errors.New("Token has expired")
 	}
 
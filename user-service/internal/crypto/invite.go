@@ -0,0 +1,17 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"strings"
+)
+
+// GenInviteCode returns an 80-bit code grouped as xxxx-xxxx-xxxx-xxxx.
+// Store it with HashCode, which ignores the dashes and case.
+func GenInviteCode() (string, error) {
+	buf := make([]byte, 10)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	code := strings.ToLower(b32.EncodeToString(buf))
+	return code[:4] + "-" + code[4:8] + "-" + code[8:12] + "-" + code[12:], nil
+}
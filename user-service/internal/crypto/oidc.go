@@ -0,0 +1,133 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Signer signs OAuth access tokens and OIDC id tokens with RS256 so
+// relying parties can verify them from the published JWKS.
+type Signer struct {
+	key    *rsa.PrivateKey
+	kid    string
+	Issuer string
+}
+
+// LoadSigner reads a PEM RSA key from OAUTH_SIGNING_KEY. Without one a
+// key is generated, and tokens stop verifying after a restart.
+func LoadSigner() (*Signer, bool, error) {
+	s := &Signer{Issuer: os.Getenv("OAUTH_ISSUER")}
+	if s.Issuer == "" {
+		s.Issuer = "http://localhost:8080/api/users/oauth"
+	}
+
+	path := os.Getenv("OAUTH_SIGNING_KEY")
+	ephemeral := path == ""
+	if ephemeral {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			return nil, false, err
+		}
+		s.key = key
+	} else {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, false, err
+		}
+		if s.key, err = parseRSAKey(data); err != nil {
+			return nil, false, err
+		}
+	}
+
+	sum := sha256.Sum256(s.key.PublicKey.N.Bytes())
+	s.kid = base64.RawURLEncoding.EncodeToString(sum[:8])
+
+	return s, ephemeral, nil
+}
+
+func parseRSAKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block in signing key")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("signing key is not RSA")
+	}
+	return rsaKey, nil
+}
+
+func (s *Signer) Sign(claims jwt.MapClaims, ttl time.Duration) (string, error) {
+	now := time.Now()
+	claims["iss"] = s.Issuer
+	claims["iat"] = now.Unix()
+	claims["exp"] = now.Add(ttl).Unix()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = s.kid
+	return token.SignedString(s.key)
+}
+
+// Verify checks signature, issuer and expiry.
+func (s *Signer) Verify(tokenString string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims,
+		func(token *jwt.Token) (any, error) {
+			return &s.key.PublicKey, nil
+		},
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithIssuer(s.Issuer),
+		jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (s *Signer) JWKS() (string, error) {
+	pub := s.key.PublicKey
+	enc := base64.RawURLEncoding
+	set := map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"use": "sig",
+			"alg": "RS256",
+			"kid": s.kid,
+			"n":   enc.EncodeToString(pub.N.Bytes()),
+			"e":   enc.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}},
+	}
+	data, err := json.Marshal(set)
+	return string(data), err
+}
+
+// CheckPKCE verifies an S256 code challenge (RFC 7636).
+func CheckPKCE(challenge, verifier string) bool {
+	if len(verifier) < 43 || len(verifier) > 128 {
+		return false
+	}
+	return PKCEChallenge(verifier) == challenge
+}
+
+func PKCEChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,35 @@
+package crypto
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckPKCE(t *testing.T) {
+	// challenge is BASE64URL(SHA256(verifier)) without padding
+	const verifier = "dBjftJeZ4CVP-mB92K27uhbUZU1p1r_wW1gFWFOEjXk"
+	const challenge = "WnCR6fkffTzxLg6_kghEFRg5SF9BC1_hGFad1RCLMME"
+
+	tests := []struct {
+		name      string
+		challenge string
+		verifier  string
+		want      bool
+	}{
+		{"known answer", challenge, verifier, true},
+		{"other verifier", challenge, verifier[:42] + "Y", false},
+		{"plain challenge", verifier, verifier, false},
+		{"too short", PKCEChallenge(verifier[:42]), verifier[:42], false},
+		{"shortest", PKCEChallenge(strings.Repeat("a", 43)), strings.Repeat("a", 43), true},
+		{"longest", PKCEChallenge(strings.Repeat("a", 128)), strings.Repeat("a", 128), true},
+		{"too long", PKCEChallenge(strings.Repeat("a", 129)), strings.Repeat("a", 129), false},
+		{"empty", "", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CheckPKCE(tt.challenge, tt.verifier); got != tt.want {
+				t.Errorf("CheckPKCE = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Hashes are stored in the PHC string format:
+// $argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>
+// so every hash carries the parameters it was made with.
+
+const (
+	saltLen = 16
+	keyLen  = 32
+)
+
+type ArgonParams struct {
+	Memory  uint32
+	Time    uint32
+	Threads uint8
+}
+
+var params = LoadArgonParams()
+
+// LoadArgonParams reads ARGON_MEMORY (KiB), ARGON_TIME and ARGON_THREADS,
+// falling back to the OWASP recommended minimum for argon2id.
+func LoadArgonParams() ArgonParams {
+	p := ArgonParams{Memory: 64 * 1024, Time: 3, Threads: 2}
+	if v, err := strconv.ParseUint(os.Getenv("ARGON_MEMORY"), 10, 32); err == nil && v > 0 {
+		p.Memory = uint32(v)
+	}
+	if v, err := strconv.ParseUint(os.Getenv("ARGON_TIME"), 10, 32); err == nil && v > 0 {
+		p.Time = uint32(v)
+	}
+	if v, err := strconv.ParseUint(os.Getenv("ARGON_THREADS"), 10, 8); err == nil && v > 0 {
+		p.Threads = uint8(v)
+	}
+	return p
+}
+
+func Hash(source string) (string, error) {
+	salt := make([]byte, saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(source), salt, params.Time, params.Memory, params.Threads, keyLen)
+
+	enc := base64.RawStdEncoding
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, params.Memory, params.Time, params.Threads,
+		enc.EncodeToString(salt), enc.EncodeToString(key)), nil
+}
+
+func CheckPswd(hash, password string) bool {
+	if !strings.HasPrefix(hash, "$argon2id$") {
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		return err == nil
+	}
+
+	p, salt, key, err := decodeArgon(hash)
+	if err != nil {
+		return false
+	}
+
+	other := argon2.IDKey([]byte(password), salt, p.Time, p.Memory, p.Threads, uint32(len(key)))
+	return subtle.ConstantTimeCompare(key, other) == 1
+}
+
+// NeedsRehash reports whether the hash was made with another algorithm
+// or with parameters different from the current ones.
+func NeedsRehash(hash string) bool {
+	p, _, key, err := decodeArgon(hash)
+	if err != nil {
+		return true
+	}
+	return p != params || len(key) != keyLen
+}
+
+func decodeArgon(hash string) (ArgonParams, []byte, []byte, error) {
+	var p ArgonParams
+
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return p, nil, nil, errors.New("not an argon2id hash")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return p, nil, nil, fmt.Errorf("parse version: %w", err)
+	}
+	if version != argon2.Version {
+		return p, nil, nil, errors.New("unsupported argon2 version")
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Time, &p.Threads); err != nil {
+		return p, nil, nil, fmt.Errorf("parse params: %w", err)
+	}
+
+	enc := base64.RawStdEncoding
+	salt, err := enc.DecodeString(parts[4])
+	if err != nil {
+		return p, nil, nil, fmt.Errorf("decode salt: %w", err)
+	}
+	key, err := enc.DecodeString(parts[5])
+	if err != nil {
+		return p, nil, nil, fmt.Errorf("decode key: %w", err)
+	}
+
+	return p, salt, key, nil
+}
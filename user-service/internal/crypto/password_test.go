@@ -0,0 +1,147 @@
+package crypto
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// cheap parameters, the tests only care about the format
+var testParams = ArgonParams{Memory: 64, Time: 1, Threads: 1}
+
+func useParams(t *testing.T, p ArgonParams) {
+	t.Helper()
+	old := params
+	params = p
+	t.Cleanup(func() { params = old })
+}
+
+func TestHashRoundTrip(t *testing.T) {
+	useParams(t, testParams)
+
+	hash, err := Hash("correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(hash, "$argon2id$v=19$m=64,t=1,p=1$") {
+		t.Errorf("hash = %q, not PHC with the current params", hash)
+	}
+
+	p, salt, key, err := decodeArgon(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p != testParams || len(salt) != saltLen || len(key) != keyLen {
+		t.Errorf("decoded %+v, salt %d, key %d", p, len(salt), len(key))
+	}
+
+	other, _ := Hash("correct horse")
+	if other == hash {
+		t.Error("two hashes share a salt")
+	}
+}
+
+func TestCheckPswd(t *testing.T) {
+	useParams(t, testParams)
+
+	argon, err := Hash("secret123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacy, err := bcrypt.GenerateFromPassword([]byte("secret123"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		hash     string
+		password string
+		want     bool
+	}{
+		{"argon2id", argon, "secret123", true},
+		{"argon2id wrong", argon, "secret124", false},
+		{"bcrypt", string(legacy), "secret123", true},
+		{"bcrypt wrong", string(legacy), "secret124", false},
+		{"malformed", "$argon2id$v=19$m=64,t=1,p=1$!!$!!", "secret123", false},
+		{"empty", "", "secret123", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CheckPswd(tt.hash, tt.password); got != tt.want {
+				t.Errorf("CheckPswd = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeArgon(t *testing.T) {
+	const salt, key = "c2FsdHNhbHRzYWx0c2FsdA", "a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U"
+
+	tests := []struct {
+		name    string
+		hash    string
+		want    ArgonParams
+		wantErr bool
+	}{
+		{"valid", "$argon2id$v=19$m=65536,t=3,p=2$" + salt + "$" + key, ArgonParams{65536, 3, 2}, false},
+		{"argon2i", "$argon2i$v=19$m=65536,t=3,p=2$" + salt + "$" + key, ArgonParams{}, true},
+		{"old version", "$argon2id$v=16$m=65536,t=3,p=2$" + salt + "$" + key, ArgonParams{}, true},
+		{"bad params", "$argon2id$v=19$m=x,t=3,p=2$" + salt + "$" + key, ArgonParams{}, true},
+		{"bad salt", "$argon2id$v=19$m=65536,t=3,p=2$%%$" + key, ArgonParams{}, true},
+		{"bad key", "$argon2id$v=19$m=65536,t=3,p=2$" + salt + "$%%", ArgonParams{}, true},
+		{"missing part", "$argon2id$v=19$m=65536,t=3,p=2$" + salt, ArgonParams{}, true},
+		{"bcrypt", "$2a$10$abcdefghijklmnopqrstuv", ArgonParams{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, _, _, err := decodeArgon(tt.hash)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && p != tt.want {
+				t.Errorf("params = %+v, want %+v", p, tt.want)
+			}
+		})
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	useParams(t, testParams)
+
+	current, err := Hash("pw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, salt, key, _ := decodeArgon(current)
+	b64 := base64.RawStdEncoding.EncodeToString
+	phc := func(p ArgonParams, key []byte) string {
+		return fmt.Sprintf("$argon2id$v=19$m=%d,t=%d,p=%d$%s$%s", p.Memory, p.Time, p.Threads,
+			b64(salt), b64(key))
+	}
+	legacy, _ := bcrypt.GenerateFromPassword([]byte("pw"), bcrypt.MinCost)
+
+	tests := []struct {
+		name string
+		hash string
+		want bool
+	}{
+		{"current", current, false},
+		{"more memory", phc(ArgonParams{128, 1, 1}, key), true},
+		{"more time", phc(ArgonParams{64, 2, 1}, key), true},
+		{"more threads", phc(ArgonParams{64, 1, 2}, key), true},
+		{"short key", phc(testParams, key[:16]), true},
+		{"bcrypt", string(legacy), true},
+		{"garbage", "not a hash", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NeedsRehash(tt.hash); got != tt.want {
+				t.Errorf("NeedsRehash = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
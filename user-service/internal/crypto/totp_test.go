@@ -0,0 +1,63 @@
+package crypto
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+)
+
+func TestHOTP(t *testing.T) {
+	// RFC 4226 appendix D
+	key := []byte("12345678901234567890")
+	want := []string{"755224", "287082", "359152", "969429", "338314",
+		"254676", "287922", "162583", "399871", "520489"}
+	for i, w := range want {
+		if got := hotp(key, int64(i)); got != w {
+			t.Errorf("hotp(%d) = %s, want %s", i, got, w)
+		}
+	}
+}
+
+func TestCheckTOTP(t *testing.T) {
+	// RFC 6238 appendix B, SHA1, last six digits
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	at := func(sec int64) time.Time { return time.Unix(sec, 0) }
+
+	tests := []struct {
+		name     string
+		secret   string
+		code     string
+		now      time.Time
+		wantStep int64
+		wantOK   bool
+	}{
+		{"t=59", secret, "287082", at(59), 1, true},
+		{"t=1111111109", secret, "081804", at(1111111109), 37037036, true},
+		{"t=1234567890", secret, "005924", at(1234567890), 41152263, true},
+		{"t=2000000000", secret, "279037", at(2000000000), 66666666, true},
+		{"previous step", secret, "287082", at(89), 1, true},
+		{"next step", secret, "287082", at(29), 1, true},
+		{"two steps late", secret, "287082", at(119), 0, false},
+		{"wrong code", secret, "123456", at(59), 0, false},
+		{"short code", secret, "28708", at(59), 0, false},
+		{"lowercase secret", "gezdgnbvgy3tqojqgezdgnbvgy3tqojq", "287082", at(59), 1, true},
+		{"bad secret", "not base32!", "287082", at(59), 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := CheckTOTP(tt.secret, tt.code, tt.now)
+			if ok != tt.wantOK || step != tt.wantStep {
+				t.Errorf("CheckTOTP = %d, %v, want %d, %v", step, ok, tt.wantStep, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestHashCode(t *testing.T) {
+	want := HashCode("abcde-fghij")
+	for _, in := range []string{"ABCDE-FGHIJ", " abcdefghij ", "abcde-fghij"} {
+		if got := HashCode(in); got != want {
+			t.Errorf("HashCode(%q) differs from the canonical form", in)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+type APIKey struct {
+	ID         string         `db:"id"`
+	UserID     string         `db:"user_id"`
+	Name       string         `db:"name"`
+	Scopes     pq.StringArray `db:"scopes"`
+	RateLimit  uint32         `db:"rate_limit"`
+	CreatedAt  time.Time      `db:"created_at"`
+	LastUsedAt sql.NullTime   `db:"last_used_at"`
+}
+
+// KeyOwner is what a presented key resolves to.
+type KeyOwner struct {
+	APIKey
+	KeyHash  string `db:"key_hash"`
+	Role     string `db:"role"`
+	Verified bool   `db:"email_verified"`
+}
+
+var apiKeyColumns = []string{
+	"k.id", "k.user_id", "k.name", "k.scopes", "k.rate_limit",
+	"k.created_at", "k.last_used_at",
+}
+
+// lastUsedEvery keeps key auth from writing on every request.
+const lastUsedEvery = time.Minute
+
+func (r *Repo) AddAPIKey(key *APIKey, keyHash string) error {
+	const op = "UserPostgresRepository.AddAPIKey"
+
+	query, args, err := r.bd.
+		Insert("api_keys").
+		Columns("id", "user_id", "name", "key_hash", "scopes", "rate_limit").
+		Values(key.ID, key.UserID, key.Name, keyHash, key.Scopes, key.RateLimit).
+		Suffix("RETURNING created_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	if err := r.db.Get(&key.CreatedAt, query, args...); err != nil {
+		return fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *Repo) ListAPIKeys(userID string) ([]APIKey, error) {
+	const op = "UserPostgresRepository.ListAPIKeys"
+
+	query, args, err := r.bd.
+		Select(apiKeyColumns...).
+		From("api_keys k").
+		Where(sq.Eq{"k.user_id": userID, "k.revoked_at": nil}).
+		OrderBy("k.created_at").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	var keys []APIKey
+	if err := r.db.Select(&keys, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return keys, nil
+}
+
+func (r *Repo) RevokeAPIKey(userID, keyID string) error {
+	const op = "UserPostgresRepository.RevokeAPIKey"
+
+	query, args, err := r.bd.
+		Update("api_keys").
+		Set("revoked_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": keyID, "user_id": userID, "revoked_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	res, err := r.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("%s: execute query: %w", op, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("%s: revoke key: %w", op, sql.ErrNoRows)
+	}
+
+	return nil
+}
+
+// GetKeyOwner loads an active key with its owner's current role.
+func (r *Repo) GetKeyOwner(keyID string) (*KeyOwner, error) {
+	const op = "UserPostgresRepository.GetKeyOwner"
+
+	query, args, err := r.bd.
+		Select(append(apiKeyColumns, "k.key_hash", "u.role", "u.email_verified")...).
+		From("api_keys k").
+		Join("users u ON u.id = k.user_id").
+		Where(sq.Eq{"k.id": keyID, "k.revoked_at": nil, "u.deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	var data KeyOwner
+	if err := r.db.Get(&data, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return &data, nil
+}
+
+func (r *Repo) TouchAPIKey(keyID string) error {
+	const op = "UserPostgresRepository.TouchAPIKey"
+
+	query, args, err := r.bd.
+		Update("api_keys").
+		Set("last_used_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": keyID}).
+		Where(sq.Or{
+			sq.Eq{"last_used_at": nil},
+			sq.Expr("last_used_at < NOW() - make_interval(secs => ?)", lastUsedEvery.Seconds()),
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	if _, err := r.db.Exec(query, args...); err != nil {
+		return fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package db
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/Votline/3l1/protos/apierr"
+)
+
+type AttemptPolicy struct {
+	Window     time.Duration
+	Lockout    time.Duration
+	MaxAccount int64
+	MaxIP      int64
+	DelayAfter int64
+	MaxDelay   time.Duration
+}
+
+func LoadAttemptPolicy() AttemptPolicy {
+	return AttemptPolicy{
+		Window:     envDuration("LOGIN_FAIL_WINDOW", 15*time.Minute),
+		Lockout:    envDuration("LOGIN_LOCKOUT", 15*time.Minute),
+		MaxAccount: envInt("LOGIN_MAX_ACCOUNT_FAILS", 10),
+		MaxIP:      envInt("LOGIN_MAX_IP_FAILS", 50),
+		DelayAfter: envInt("LOGIN_DELAY_AFTER", 3),
+		MaxDelay:   envDuration("LOGIN_MAX_DELAY", time.Minute),
+	}
+}
+
+func envDuration(key string, def time.Duration) time.Duration {
+	if d, err := time.ParseDuration(os.Getenv(key)); err == nil {
+		return d
+	}
+	return def
+}
+
+func envInt(key string, def int64) int64 {
+	if n, err := strconv.ParseInt(os.Getenv(key), 10, 64); err == nil {
+		return n
+	}
+	return def
+}
+
+// incrWindow starts the expiry on the first hit so the counter
+// covers a fixed window.
+var incrWindow = redis.NewScript(`
+local n = redis.call('INCR', KEYS[1])
+if n == 1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return n
+`)
+
+type LoginBlock struct {
+	Scope      string
+	RetryAfter time.Duration
+}
+
+func (b *LoginBlock) Error() string {
+	return fmt.Sprintf("Too many failed logins for %s, retry after %s",
+		b.Scope, b.RetryAfter.Round(time.Second))
+}
+
+// GRPCStatus leaves out the scope, an account lock would tell that the
+// account exists.
+func (b *LoginBlock) GRPCStatus() *status.Status {
+	return apierr.New(codes.ResourceExhausted, apierr.ReasonRateLimited,
+		"Too many failed logins, retry after "+b.RetryAfter.Round(time.Second).String(),
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(b.RetryAfter)})
+}
+
+// CheckLogin returns a *LoginBlock if the account or IP may not try yet.
+// Pass an empty account to check only the IP.
+func (r *RedisRepo) CheckLogin(account, ip string) error {
+	const op = "UserRedisRepository.CheckLogin"
+
+	var keys [][2]string
+	if ip != "" {
+		keys = append(keys, [2]string{"ip", "lock:ip:" + ip})
+	}
+	if account != "" {
+		keys = append(keys,
+			[2]string{"account", "lock:acct:" + account},
+			[2]string{"account", "next:acct:" + account})
+	}
+
+	for _, k := range keys {
+		ttl, err := r.rdb.PTTL(r.ctx, k[1]).Result()
+		if err != nil {
+			return fmt.Errorf("%s: get ttl: %w", op, err)
+		}
+		if ttl > 0 {
+			return &LoginBlock{Scope: k[0], RetryAfter: ttl}
+		}
+	}
+	return nil
+}
+
+// FailLogin records a failed attempt and reports which scopes got locked.
+func (r *RedisRepo) FailLogin(account, ip string, p AttemptPolicy) ([]string, error) {
+	const op = "UserRedisRepository.FailLogin"
+
+	var locked []string
+	if ip != "" {
+		n, err := incrWindow.Run(r.ctx, r.rdb,
+			[]string{"fail:ip:" + ip}, p.Window.Milliseconds()).Int64()
+		if err != nil {
+			return nil, fmt.Errorf("%s: count ip: %w", op, err)
+		}
+		if n >= p.MaxIP {
+			if err := r.rdb.Set(r.ctx, "lock:ip:"+ip, 1, p.Lockout).Err(); err != nil {
+				return nil, fmt.Errorf("%s: lock ip: %w", op, err)
+			}
+			r.rdb.Del(r.ctx, "fail:ip:"+ip)
+			locked = append(locked, "ip")
+		}
+	}
+
+	if account == "" {
+		return locked, nil
+	}
+
+	n, err := incrWindow.Run(r.ctx, r.rdb,
+		[]string{"fail:acct:" + account}, p.Window.Milliseconds()).Int64()
+	if err != nil {
+		return nil, fmt.Errorf("%s: count account: %w", op, err)
+	}
+
+	switch {
+	case n >= p.MaxAccount:
+		if err := r.rdb.Set(r.ctx, "lock:acct:"+account, 1, p.Lockout).Err(); err != nil {
+			return nil, fmt.Errorf("%s: lock account: %w", op, err)
+		}
+		r.rdb.Del(r.ctx, "fail:acct:"+account)
+		locked = append(locked, "account")
+	case n >= p.DelayAfter:
+		delay := time.Second << min(n-p.DelayAfter, 30)
+		delay = min(delay, p.MaxDelay)
+		if err := r.rdb.Set(r.ctx, "next:acct:"+account, 1, delay).Err(); err != nil {
+			return nil, fmt.Errorf("%s: delay account: %w", op, err)
+		}
+	}
+
+	return locked, nil
+}
+
+func (r *RedisRepo) ResetLogin(account string) error {
+	const op = "UserRedisRepository.ResetLogin"
+
+	if err := r.rdb.Del(r.ctx,
+		"fail:acct:"+account,
+		"lock:acct:"+account,
+		"next:acct:"+account).Err(); err != nil {
+		return fmt.Errorf("%s: delete entries: %w", op, err)
+	}
+	return nil
+}
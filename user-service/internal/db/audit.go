@@ -0,0 +1,32 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+func (r *Repo) addAudit(tx *sqlx.Tx, actorID, action, targetID string, details map[string]any) error {
+	const op = "UserPostgresRepository.addAudit"
+
+	raw, err := json.Marshal(details)
+	if err != nil {
+		return fmt.Errorf("%s: marshal details: %w", op, err)
+	}
+
+	query, args, err := r.bd.
+		Insert("audit_log").
+		Columns("actor_id", "action", "target_id", "details").
+		Values(actorID, action, targetID, string(raw)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+
+	if _, err := tx.Exec(query, args...); err != nil {
+		return fmt.Errorf("%s: execute tx query: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package db
+
+import (
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// Deletion saga states. Pending sagas that run out of attempts are
+// compensated; once orders are handled the saga only moves forward.
+const (
+	DeletionPending      = "pending"
+	DeletionOrdersDone   = "orders_done"
+	DeletionUserDeleted  = "user_deleted"
+	DeletionCompleted    = "completed"
+	DeletionCompensating = "compensating"
+	DeletionCompensated  = "compensated"
+)
+
+type Deletion struct {
+	ID               string    `db:"id"`
+	UserID           string    `db:"user_id"`
+	RequestedBy      string    `db:"requested_by"`
+	State            string    `db:"state"`
+	Attempts         int       `db:"attempts"`
+	LastError        string    `db:"last_error"`
+	OrdersCancelled  int64     `db:"orders_cancelled"`
+	OrdersAnonymised int64     `db:"orders_anonymised"`
+	NextAttemptAt    time.Time `db:"next_attempt_at"`
+	CreatedAt        time.Time `db:"created_at"`
+	UpdatedAt        time.Time `db:"updated_at"`
+}
+
+var deletionColumns = []string{
+	"id", "user_id", "requested_by", "state", "attempts", "last_error",
+	"orders_cancelled", "orders_anonymised", "next_attempt_at",
+	"created_at", "updated_at",
+}
+
+var deletionDone = []string{DeletionCompleted, DeletionCompensated}
+
+type SagaPolicy struct {
+	Interval    time.Duration
+	MaxAttempts int
+	MaxBackoff  time.Duration
+	Lease       time.Duration
+}
+
+func LoadSagaPolicy() SagaPolicy {
+	return SagaPolicy{
+		Interval:    envDuration("SAGA_POLL_INTERVAL", 2*time.Second),
+		MaxAttempts: int(envInt("SAGA_MAX_ATTEMPTS", 8)),
+		MaxBackoff:  envDuration("SAGA_MAX_BACKOFF", 5*time.Minute),
+		Lease:       time.Minute,
+	}
+}
+
+// Backoff doubles the wait with every failed attempt.
+func (p SagaPolicy) Backoff(attempts int) time.Duration {
+	return min(time.Second<<min(attempts, 30), p.MaxBackoff)
+}
+
+// ClaimDeletions picks due sagas and pushes their next attempt past the
+// lease, so other replicas skip them while this one works.
+func (r *Repo) ClaimDeletions(limit uint64, lease time.Duration) ([]Deletion, error) {
+	const op = "UserPostgresRepository.ClaimDeletions"
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return nil, fmt.Errorf("%s: create transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := r.bd.
+		Select(deletionColumns...).
+		From("deletion_sagas").
+		Where(sq.NotEq{"state": deletionDone}).
+		Where("next_attempt_at <= NOW()").
+		OrderBy("next_attempt_at").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+
+	var sagas []Deletion
+	if err := tx.Select(&sagas, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: execute tx query: %w", op, err)
+	}
+	if len(sagas) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, len(sagas))
+	for i, s := range sagas {
+		ids[i] = s.ID
+	}
+	query, args, err = r.bd.
+		Update("deletion_sagas").
+		Set("next_attempt_at", time.Now().Add(lease)).
+		Where(sq.Eq{"id": ids}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+	if _, err := tx.Exec(query, args...); err != nil {
+		return nil, fmt.Errorf("%s: lease sagas: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return sagas, nil
+}
+
+func (r *Repo) SaveDeletion(d *Deletion) error {
+	const op = "UserPostgresRepository.SaveDeletion"
+
+	query, args, err := r.bd.
+		Update("deletion_sagas").
+		Set("state", d.State).
+		Set("attempts", d.Attempts).
+		Set("last_error", d.LastError).
+		Set("orders_cancelled", d.OrdersCancelled).
+		Set("orders_anonymised", d.OrdersAnonymised).
+		Set("next_attempt_at", d.NextAttemptAt).
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": d.ID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	if _, err := r.db.Exec(query, args...); err != nil {
+		return fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return nil
+}
+
+// PurgeUser removes a user marked by DelUser. A missing row is fine, the
+// step may be repeated.
+func (r *Repo) PurgeUser(id string) error {
+	const op = "UserPostgresRepository.PurgeUser"
+
+	query, args, err := r.bd.
+		Delete("users").
+		Where(sq.Eq{"id": id}).
+		Where(sq.NotEq{"deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	if _, err := r.db.Exec(query, args...); err != nil {
+		return fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return nil
+}
+
+// RestoreUser undoes DelUser's mark when the saga is compensated.
+func (r *Repo) RestoreUser(id string) error {
+	const op = "UserPostgresRepository.RestoreUser"
+
+	query, args, err := r.bd.
+		Update("users").
+		Set("deleted_at", nil).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	if _, err := r.db.Exec(query, args...); err != nil {
+		return fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return nil
+}
+
+// ListDeletions returns sagas newest first, only unfinished ones unless
+// all is set. A non-empty id selects a single saga.
+func (r *Repo) ListDeletions(id string, all bool, limit uint64) ([]Deletion, error) {
+	const op = "UserPostgresRepository.ListDeletions"
+
+	q := r.bd.
+		Select(deletionColumns...).
+		From("deletion_sagas").
+		OrderBy("created_at DESC").
+		Limit(limit)
+	if id != "" {
+		q = q.Where(sq.Eq{"id": id})
+	} else if !all {
+		q = q.Where(sq.NotEq{"state": deletionDone})
+	}
+
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	var sagas []Deletion
+	if err := r.db.Select(&sagas, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return sagas, nil
+}
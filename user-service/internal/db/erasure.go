@@ -0,0 +1,110 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/Votline/3l1/protos/apierr"
+)
+
+type ErasureRecord struct {
+	ID          string           `db:"id"`
+	SubjectHash string           `db:"subject_hash"`
+	RequestedBy string           `db:"requested_by"`
+	Steps       map[string]int64 `db:"-"`
+	StepsRaw    []byte           `db:"steps"`
+	CompletedAt time.Time        `db:"completed_at"`
+	Receipt     string           `db:"receipt"`
+}
+
+// EraseUser deletes the user with everything that cascades from it,
+// replaces the user id in the audit log with the subject hash and
+// stores rec, all in one transaction.
+func (r *Repo) EraseUser(id string, rec *ErasureRecord) error {
+	const op = "UserPostgresRepository.EraseUser"
+
+	steps, err := json.Marshal(rec.Steps)
+	if err != nil {
+		return fmt.Errorf("%s: marshal steps: %w", op, err)
+	}
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("%s: create transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := r.bd.
+		Delete("users").
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+	res, err := tx.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("%s: execute tx query: %w", op, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("%s: delete user: %w", op, apierr.NotFound("User not found"))
+	}
+
+	pseudonym := "erased:" + rec.SubjectHash
+	for _, col := range []string{"actor_id", "target_id"} {
+		query, args, err := r.bd.
+			Update("audit_log").
+			Set(col, pseudonym).
+			Where(sq.Eq{col: id}).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("%s: create tx query: %w", op, err)
+		}
+		if _, err := tx.Exec(query, args...); err != nil {
+			return fmt.Errorf("%s: pseudonymise audit %s: %w", op, col, err)
+		}
+	}
+
+	query, args, err = r.bd.
+		Insert("erasure_records").
+		Columns("id", "subject_hash", "requested_by", "steps", "completed_at", "receipt").
+		Values(rec.ID, rec.SubjectHash, rec.RequestedBy, string(steps), rec.CompletedAt, rec.Receipt).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+	if _, err := tx.Exec(query, args...); err != nil {
+		return fmt.Errorf("%s: execute tx query: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *Repo) GetErasure(id string) (*ErasureRecord, error) {
+	const op = "UserPostgresRepository.GetErasure"
+
+	query, args, err := r.bd.
+		Select("id", "subject_hash", "requested_by", "steps", "completed_at", "receipt").
+		From("erasure_records").
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	var rec ErasureRecord
+	if err := r.db.Get(&rec, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: execute query: %w", op, err)
+	}
+	if err := json.Unmarshal(rec.StepsRaw, &rec.Steps); err != nil {
+		return nil, fmt.Errorf("%s: unmarshal steps: %w", op, err)
+	}
+
+	return &rec, nil
+}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/Votline/3l1/protos/apierr"
+	"github.com/Votline/3l1/protos/authz"
+)
+
+// Impersonate checks the target and records the impersonation in the
+// audit log, returning the target's role.
+func (r *Repo) Impersonate(actorID, targetID, reason string) (string, error) {
+	const op = "UserPostgresRepository.Impersonate"
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return "", fmt.Errorf("%s: create transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	role, err := r.getUserRole(targetID, tx)
+	if err != nil {
+		return "", fmt.Errorf("%s: get target role: %w", op, err)
+	}
+
+	// admins cannot act as each other
+	peer, err := r.roleHas(tx, role, authz.UsersImpersonate)
+	if err != nil {
+		return "", fmt.Errorf("%s: check target role: %w", op, err)
+	}
+	if peer {
+		return "", fmt.Errorf("%s: check role: %w", op, apierr.PermissionDenied("Cannot impersonate a user with the same privileges"))
+	}
+
+	if err := r.addAudit(tx, actorID, "user.impersonate", targetID, map[string]any{
+		"role":   role,
+		"reason": reason,
+	}); err != nil {
+		return "", fmt.Errorf("%s: add audit: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return role, nil
+}
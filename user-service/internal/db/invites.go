@@ -0,0 +1,226 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/Votline/3l1/protos/apierr"
+)
+
+var ErrInvalidInvite = apierr.Invalid("Invalid, expired or used up invite code")
+
+type Invite struct {
+	ID        string       `db:"id"`
+	Role      string       `db:"role"`
+	Note      string       `db:"note"`
+	MaxUses   int32        `db:"max_uses"`
+	Uses      int32        `db:"uses"`
+	ExpiresAt time.Time    `db:"expires_at"`
+	CreatedBy string       `db:"created_by"`
+	CreatedAt time.Time    `db:"created_at"`
+	RevokedAt sql.NullTime `db:"revoked_at"`
+}
+
+var inviteColumns = []string{
+	"id", "role", "note", "max_uses", "uses", "expires_at",
+	"created_by", "created_at", "revoked_at",
+}
+
+type Redemption struct {
+	UserID     string    `db:"user_id"`
+	Name       string    `db:"name"`
+	RedeemedAt time.Time `db:"redeemed_at"`
+}
+
+func (r *Repo) AddInvite(inv *Invite, codeHash string) error {
+	const op = "UserPostgresRepository.AddInvite"
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("%s: create transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := r.bd.
+		Insert("invite_codes").
+		Columns("id", "code_hash", "role", "note", "max_uses", "expires_at", "created_by").
+		Values(inv.ID, codeHash, inv.Role, inv.Note, inv.MaxUses, inv.ExpiresAt, inv.CreatedBy).
+		Suffix("RETURNING created_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+
+	if err := tx.Get(&inv.CreatedAt, query, args...); err != nil {
+		return fmt.Errorf("%s: execute tx query: %w", op, err)
+	}
+
+	if err := r.addAudit(tx, inv.CreatedBy, "invite.create", inv.ID, map[string]any{
+		"role":     inv.Role,
+		"max_uses": inv.MaxUses,
+	}); err != nil {
+		return fmt.Errorf("%s: add audit: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return nil
+}
+
+// ListInvites returns invites newest first, only usable ones unless all
+// is set.
+func (r *Repo) ListInvites(all bool) ([]Invite, error) {
+	const op = "UserPostgresRepository.ListInvites"
+
+	q := r.bd.
+		Select(inviteColumns...).
+		From("invite_codes").
+		OrderBy("created_at DESC")
+	if !all {
+		q = q.Where(sq.Eq{"revoked_at": nil}).
+			Where("expires_at > NOW()").
+			Where("uses < max_uses")
+	}
+
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	var invites []Invite
+	if err := r.db.Select(&invites, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return invites, nil
+}
+
+func (r *Repo) ListRedemptions(inviteID string) ([]Redemption, error) {
+	const op = "UserPostgresRepository.ListRedemptions"
+
+	query, args, err := r.bd.
+		Select("r.user_id", "u.name", "r.redeemed_at").
+		From("invite_redemptions r").
+		Join("users u ON u.id = r.user_id").
+		Where(sq.Eq{"r.invite_id": inviteID}).
+		OrderBy("r.redeemed_at").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	var redemptions []Redemption
+	if err := r.db.Select(&redemptions, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return redemptions, nil
+}
+
+func (r *Repo) RevokeInvite(actorID, id string) error {
+	const op = "UserPostgresRepository.RevokeInvite"
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("%s: create transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := r.bd.
+		Update("invite_codes").
+		Set("revoked_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": id, "revoked_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+
+	res, err := tx.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("%s: execute tx query: %w", op, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("%s: revoke invite: %w", op, apierr.NotFound("Invite not found"))
+	}
+
+	if err := r.addAudit(tx, actorID, "invite.revoke", id, map[string]any{}); err != nil {
+		return fmt.Errorf("%s: add audit: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return nil
+}
+
+// AddInvitedUser consumes one use of the invite and adds the user with
+// the invite's role in the same transaction. It returns that role.
+func (r *Repo) AddInvitedUser(id, name, email, pswd, codeHash string) (string, error) {
+	const op = "UserPostgresRepository.AddInvitedUser"
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return "", fmt.Errorf("%s: create transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := r.bd.
+		Update("invite_codes").
+		Set("uses", sq.Expr("uses + 1")).
+		Where(sq.Eq{"code_hash": codeHash, "revoked_at": nil}).
+		Where("expires_at > NOW()").
+		Where("uses < max_uses").
+		Suffix("RETURNING id, role").
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+
+	var invite struct {
+		ID   string `db:"id"`
+		Role string `db:"role"`
+	}
+	if err := tx.Get(&invite, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", fmt.Errorf("%s: %w", op, ErrInvalidInvite)
+		}
+		return "", fmt.Errorf("%s: redeem invite: %w", op, err)
+	}
+
+	query, args, err = r.bd.
+		Insert("users").
+		Columns("id", "name", "email", "role", "pswd").
+		Values(id, name, email, invite.Role, pswd).
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+	if _, err := tx.Exec(query, args...); err != nil {
+		return "", fmt.Errorf("%s: add user: %w", op, err)
+	}
+
+	query, args, err = r.bd.
+		Insert("invite_redemptions").
+		Columns("invite_id", "user_id").
+		Values(invite.ID, id).
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+	if _, err := tx.Exec(query, args...); err != nil {
+		return "", fmt.Errorf("%s: add redemption: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return invite.Role, nil
+}
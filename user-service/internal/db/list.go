@@ -0,0 +1,103 @@
+package db
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/Votline/3l1/protos/apierr"
+)
+
+var errMalformedCursor = apierr.Invalid("Malformed cursor")
+
+type UserFilter struct {
+	Query       string
+	Role        string
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	Cursor      string
+	Limit       uint64
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// ListUsers pages by (created_at, id); the cursor is the last row's key.
+func (r *Repo) ListUsers(f UserFilter) ([]Profile, string, error) {
+	const op = "UserPostgresRepository.ListUsers"
+
+	q := r.bd.
+		Select(profileColumns...).
+		From("users").
+		OrderBy("created_at", "id").
+		Limit(f.Limit + 1)
+
+	if f.Query != "" {
+		prefix := strings.ToLower(likeEscaper.Replace(f.Query)) + "%"
+		q = q.Where(sq.Or{
+			sq.Expr("lower(name) LIKE ?", prefix),
+			sq.Expr("lower(email) LIKE ?", prefix),
+		})
+	}
+	if f.Role != "" {
+		q = q.Where(sq.Eq{"role": f.Role})
+	}
+	if !f.CreatedFrom.IsZero() {
+		q = q.Where(sq.GtOrEq{"created_at": f.CreatedFrom})
+	}
+	if !f.CreatedTo.IsZero() {
+		q = q.Where(sq.Lt{"created_at": f.CreatedTo})
+	}
+	if f.Cursor != "" {
+		at, id, err := decodeCursor(f.Cursor)
+		if err != nil {
+			return nil, "", fmt.Errorf("%s: decode cursor: %v: %w", op, err, errMalformedCursor)
+		}
+		q = q.Where("(created_at, id) > (?, ?)", at, id)
+	}
+
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	var users []Profile
+	if err := r.db.Select(&users, query, args...); err != nil {
+		return nil, "", fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	var next string
+	if uint64(len(users)) > f.Limit {
+		users = users[:f.Limit]
+		last := users[len(users)-1]
+		next = encodeCursor(last.CreatedAt, last.ID)
+	}
+
+	return users, next, nil
+}
+
+func encodeCursor(at time.Time, id string) string {
+	raw := at.UTC().Format(time.RFC3339Nano) + "|" + id
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(cursor string) (time.Time, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+
+	ts, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return time.Time{}, "", errors.New("missing separator")
+	}
+
+	at, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+	return at, id, nil
+}
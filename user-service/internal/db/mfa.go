@@ -0,0 +1,183 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/Votline/3l1/protos/apierr"
+)
+
+type TOTP struct {
+	Account string         `db:"account"`
+	Role    string         `db:"role"`
+	Secret  sql.NullString `db:"totp_secret"`
+	Enabled bool           `db:"totp_enabled"`
+}
+
+func (r *Repo) GetTOTP(id string) (*TOTP, error) {
+	const op = "UserPostgresRepository.GetTOTP"
+
+	query, args, err := r.bd.
+		Select("COALESCE(email, name) AS account", "role", "totp_secret", "totp_enabled").
+		From("users").
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	var data TOTP
+	if err := r.db.Get(&data, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return &data, nil
+}
+
+func (r *Repo) SetTOTPSecret(id, secret string) error {
+	const op = "UserPostgresRepository.SetTOTPSecret"
+
+	query, args, err := r.bd.
+		Update("users").
+		Set("totp_secret", secret).
+		Where(sq.Eq{"id": id, "totp_enabled": false}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	res, err := r.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("%s: execute query: %w", op, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("%s: update user: %w", op, apierr.Conflict("TOTP is already enabled"))
+	}
+
+	return nil
+}
+
+func (r *Repo) EnableTOTP(id string, codeHashes []string) error {
+	const op = "UserPostgresRepository.EnableTOTP"
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("%s: create transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := r.bd.
+		Update("users").
+		Set("totp_enabled", true).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+	if _, err := tx.Exec(query, args...); err != nil {
+		return fmt.Errorf("%s: execute tx query: %w", op, err)
+	}
+
+	query, args, err = r.bd.
+		Delete("recovery_codes").
+		Where(sq.Eq{"user_id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+	if _, err := tx.Exec(query, args...); err != nil {
+		return fmt.Errorf("%s: execute tx query: %w", op, err)
+	}
+
+	ins := r.bd.Insert("recovery_codes").Columns("user_id", "code_hash")
+	for _, h := range codeHashes {
+		ins = ins.Values(id, h)
+	}
+	query, args, err = ins.ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+	if _, err := tx.Exec(query, args...); err != nil {
+		return fmt.Errorf("%s: execute tx query: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *Repo) UseRecoveryCode(id, codeHash string) (bool, error) {
+	const op = "UserPostgresRepository.UseRecoveryCode"
+
+	query, args, err := r.bd.
+		Update("recovery_codes").
+		Set("used_at", time.Now()).
+		Where(sq.Eq{"user_id": id, "code_hash": codeHash, "used_at": nil}).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	res, err := r.db.Exec(query, args...)
+	if err != nil {
+		return false, fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("%s: rows affected: %w", op, err)
+	}
+	return n == 1, nil
+}
+
+func (r *Repo) SetMFARole(role string, required bool) error {
+	const op = "UserPostgresRepository.SetMFARole"
+
+	var q sq.Sqlizer
+	if required {
+		q = r.bd.Insert("mfa_roles").Columns("role").Values(role).
+			Suffix("ON CONFLICT DO NOTHING")
+	} else {
+		q = r.bd.Delete("mfa_roles").Where(sq.Eq{"role": role})
+	}
+
+	query, args, err := q.ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	if _, err := r.db.Exec(query, args...); err != nil {
+		return fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *Repo) IsMFARequired(role string) (bool, error) {
+	const op = "UserPostgresRepository.IsMFARequired"
+
+	query, args, err := r.bd.
+		Select("role").
+		From("mfa_roles").
+		Where(sq.Eq{"role": role}).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	var found string
+	if err := r.db.Get(&found, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return true, nil
+}
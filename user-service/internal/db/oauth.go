@@ -0,0 +1,198 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type OAuthClient struct {
+	ID           string         `db:"id"`
+	OwnerID      string         `db:"owner_id"`
+	Name         string         `db:"name"`
+	SecretHash   sql.NullString `db:"secret_hash"`
+	RedirectURIs pq.StringArray `db:"redirect_uris"`
+	Scopes       pq.StringArray `db:"scopes"`
+	CreatedAt    time.Time      `db:"created_at"`
+}
+
+var clientColumns = []string{
+	"id", "owner_id", "name", "secret_hash", "redirect_uris", "scopes", "created_at",
+}
+
+func (r *Repo) AddClient(c *OAuthClient) error {
+	const op = "UserPostgresRepository.AddClient"
+
+	query, args, err := r.bd.
+		Insert("oauth_clients").
+		Columns("id", "owner_id", "name", "secret_hash", "redirect_uris", "scopes").
+		Values(c.ID, c.OwnerID, c.Name, c.SecretHash, c.RedirectURIs, c.Scopes).
+		Suffix("RETURNING created_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	if err := r.db.Get(&c.CreatedAt, query, args...); err != nil {
+		return fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *Repo) GetClient(id string) (*OAuthClient, error) {
+	const op = "UserPostgresRepository.GetClient"
+
+	query, args, err := r.bd.
+		Select(clientColumns...).
+		From("oauth_clients").
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	var data OAuthClient
+	if err := r.db.Get(&data, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return &data, nil
+}
+
+func (r *Repo) ListClients(ownerID string) ([]OAuthClient, error) {
+	const op = "UserPostgresRepository.ListClients"
+
+	query, args, err := r.bd.
+		Select(clientColumns...).
+		From("oauth_clients").
+		Where(sq.Eq{"owner_id": ownerID}).
+		OrderBy("created_at").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	var clients []OAuthClient
+	if err := r.db.Select(&clients, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return clients, nil
+}
+
+func (r *Repo) DelClient(ownerID, id string) error {
+	const op = "UserPostgresRepository.DelClient"
+
+	query, args, err := r.bd.
+		Delete("oauth_clients").
+		Where(sq.Eq{"id": id, "owner_id": ownerID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	res, err := r.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("%s: execute query: %w", op, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("%s: delete client: %w", op, sql.ErrNoRows)
+	}
+
+	return nil
+}
+
+// GetConsent returns the scopes the user already granted the client,
+// nil if none.
+func (r *Repo) GetConsent(userID, clientID string) ([]string, error) {
+	const op = "UserPostgresRepository.GetConsent"
+
+	query, args, err := r.bd.
+		Select("scopes").
+		From("oauth_consents").
+		Where(sq.Eq{"user_id": userID, "client_id": clientID}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	var scopes pq.StringArray
+	if err := r.db.Get(&scopes, query, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return scopes, nil
+}
+
+func (r *Repo) SaveConsent(userID, clientID string, scopes []string) error {
+	const op = "UserPostgresRepository.SaveConsent"
+
+	query, args, err := r.bd.
+		Insert("oauth_consents").
+		Columns("user_id", "client_id", "scopes").
+		Values(userID, clientID, pq.StringArray(scopes)).
+		Suffix("ON CONFLICT (user_id, client_id) DO UPDATE SET scopes = EXCLUDED.scopes, granted_at = NOW()").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	if _, err := r.db.Exec(query, args...); err != nil {
+		return fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return nil
+}
+
+const authCodeTTL = time.Minute
+
+type AuthCode struct {
+	ClientID    string
+	UserID      string
+	RedirectURI string
+	Scopes      []string
+	Challenge   string
+	Nonce       string
+}
+
+func (r *RedisRepo) NewAuthCode(c AuthCode) (string, error) {
+	const op = "UserRedisRepository.NewAuthCode"
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("%s: marshal code: %w", op, err)
+	}
+
+	code := uuid.NewString()
+	if err := r.rdb.Set(r.ctx, "oauth:code:"+code, data, authCodeTTL).Err(); err != nil {
+		return "", fmt.Errorf("%s: set entry: %w", op, err)
+	}
+
+	return code, nil
+}
+
+// UseAuthCode returns the code's grant and deletes it, codes are single use.
+func (r *RedisRepo) UseAuthCode(code string) (*AuthCode, error) {
+	const op = "UserRedisRepository.UseAuthCode"
+
+	data, err := r.rdb.GetDel(r.ctx, "oauth:code:"+code).Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("%s: get entry: %w", op, err)
+	}
+
+	var c AuthCode
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("%s: unmarshal code: %w", op, err)
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+func (r *Repo) RolePermissions(role string) ([]string, error) {
+	const op = "UserPostgresRepository.RolePermissions"
+
+	query, args, err := r.bd.
+		Select("permission").
+		From("role_permissions").
+		Where(sq.Eq{"role": role}).
+		OrderBy("permission").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	var perms []string
+	if err := r.db.Select(&perms, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return perms, nil
+}
+
+func (r *Repo) roleHas(tx *sqlx.Tx, role, perm string) (bool, error) {
+	query, args, err := r.bd.
+		Select("count(*) > 0").
+		From("role_permissions").
+		Where(sq.Eq{"role": role, "permission": perm}).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("create tx query: %w", err)
+	}
+
+	var ok bool
+	if err := tx.Get(&ok, query, args...); err != nil {
+		return false, fmt.Errorf("execute tx query: %w", err)
+	}
+
+	return ok, nil
+}
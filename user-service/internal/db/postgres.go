@@ -2,16 +2,22 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"os"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 	"go.uber.org/zap"
 
 	gc "users/internal/graceful"
+
+	"github.com/Votline/3l1/protos/apierr"
+	"github.com/Votline/3l1/protos/authz"
 )
 
 type Repo struct {
@@ -58,18 +64,22 @@ func (r *Repo) Stop(ctx context.Context) error {
 }
 
 type User struct {
-	ID   string
-	Role string
-	Pswd string
+	ID          string
+	Role        string
+	Pswd        string
+	Verified    bool
+	TOTPEnabled bool
+	// Legacy is set when the row still holds a concatenated name+email.
+	Legacy bool
 }
 
-func (r *Repo) AddUser(id, userName, role, pswd string) error {
+func (r *Repo) AddUser(id, name, email, role, pswd string) error {
 	const op = "UserPostgresRepository.AddUser"
 
 	query, args, err := r.bd.
 		Insert("users").
-		Columns("id", "user_name", "role", "pswd").
-		Values(id, userName, role, pswd).
+		Columns("id", "name", "email", "role", "pswd").
+		Values(id, name, email, role, pswd).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("%s: create query: %w", op, err)
@@ -82,29 +92,146 @@ func (r *Repo) AddUser(id, userName, role, pswd string) error {
 	return nil
 }
 
-func (r *Repo) LogUser(userName, pswd string) (*User, error) {
+// LogUser finds a user by email or, when email is empty, by name. If both
+// are given and nothing matches, it falls back to a row flagged by the
+// migration as legacy whose name is the two concatenated. Only flagged
+// rows are tried, a current user's name never matches by accident.
+func (r *Repo) LogUser(name, email string) (*User, error) {
 	const op = "UserPostgresRepository.LogUser"
 
-	query, args, err := r.bd.
-		Select("id", "role", "pswd").
+	q := r.bd.
+		Select("id", "role", "pswd", "email_verified", "totp_enabled").
 		From("users").
-		Where(sq.Eq{"user_name": userName}).
-		ToSql()
+		Where(sq.Eq{"deleted_at": nil})
+	if email != "" {
+		q = q.Where("lower(email) = lower(?)", email)
+	} else {
+		q = q.Where(sq.Eq{"name": name})
+	}
+
+	data, err := r.scanUser(q)
+	if errors.Is(err, sql.ErrNoRows) && name != "" && email != "" {
+		data, err = r.scanUser(r.bd.
+			Select("id", "role", "pswd", "email_verified", "totp_enabled").
+			From("users").
+			Where(sq.Eq{"name": name + email, "legacy_name": true, "deleted_at": nil}))
+		if err == nil {
+			data.Legacy = true
+		}
+	}
 	if err != nil {
-		return nil, fmt.Errorf("%s: create query: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return data, nil
+}
+
+func (r *Repo) scanUser(q sq.SelectBuilder) (*User, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("create query: %w", err)
 	}
 
 	var data User
 	if err := r.db.QueryRow(query, args...).Scan(
 		&data.ID,
 		&data.Role,
-		&data.Pswd); err != nil {
-		return nil, fmt.Errorf("%s: execute query: %w", op, err)
+		&data.Pswd,
+		&data.Verified,
+		&data.TOTPEnabled); err != nil {
+		return nil, fmt.Errorf("execute query: %w", err)
 	}
 
 	return &data, nil
 }
 
+// SplitLegacyName stores the split the owner logged in with and clears
+// the legacy flag, so the row is only ever split once.
+func (r *Repo) SplitLegacyName(id, name, email string) error {
+	const op = "UserPostgresRepository.SplitLegacyName"
+
+	query, args, err := r.bd.
+		Update("users").
+		Set("name", name).
+		Set("email", email).
+		Set("legacy_name", false).
+		Where(sq.Eq{"id": id, "legacy_name": true}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	if _, err := r.db.Exec(query, args...); err != nil {
+		return fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return nil
+}
+
+// RehashPassword swaps the stored hash only if it still equals oldHash,
+// so a password changed meanwhile is never overwritten.
+func (r *Repo) RehashPassword(id, oldHash, newHash string) error {
+	const op = "UserPostgresRepository.RehashPassword"
+
+	query, args, err := r.bd.
+		Update("users").
+		Set("pswd", newHash).
+		Where(sq.Eq{"id": id, "pswd": oldHash}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	if _, err := r.db.Exec(query, args...); err != nil {
+		return fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *Repo) SetVerified(id string) error {
+	const op = "UserPostgresRepository.SetVerified"
+
+	query, args, err := r.bd.
+		Update("users").
+		Set("email_verified", true).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	res, err := r.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("%s: execute query: %w", op, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("%s: update user: %w", op, apierr.NotFound("User not found"))
+	}
+
+	return nil
+}
+
+func (r *Repo) IsVerified(id string) (bool, error) {
+	const op = "UserPostgresRepository.IsVerified"
+
+	query, args, err := r.bd.
+		Select("email_verified").
+		From("users").
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	var verified bool
+	if err := r.db.Get(&verified, query, args...); err != nil {
+		return false, fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return verified, nil
+}
+
 func (r *Repo) getUserRole(userID string, tx *sqlx.Tx) (string, error) {
 	const op = "UserPostgresRepository.getUserRole"
 
@@ -125,41 +252,70 @@ func (r *Repo) getUserRole(userID string, tx *sqlx.Tx) (string, error) {
 	return role, nil
 }
 
-func (r *Repo) DelUser(userID, role, delUserID string) error {
+// DelUser checks access, marks the user as being deleted and starts the
+// deletion saga that finishes it. It returns the saga id.
+func (r *Repo) DelUser(userID, delUserID string, perms authz.Set) (string, error) {
 	const op = "UserPostgresRepository.DelUser"
 
 	tx, err := r.db.Beginx()
 	if err != nil {
-		return fmt.Errorf("%s: create transaction: %w", op, err)
+		return "", fmt.Errorf("%s: create transaction: %w", op, err)
 	}
+	defer tx.Rollback()
 
-	q := r.bd.Delete("users").Where(sq.Eq{"id": delUserID})
-
-	if userID != delUserID {
-		if role != "admin" {
-			return fmt.Errorf("%s: match id's: %s", op, "User ID's don't match")
-		}
+	own := userID == delUserID
+	if !perms.CanOn(authz.UsersDelete, own) {
+		return "", fmt.Errorf("%s: check permission: %w", op, apierr.PermissionDenied("Not allowed to delete this user"))
+	}
+	if !own {
 		delRole, err := r.getUserRole(delUserID, tx)
 		if err != nil {
-			return fmt.Errorf("%s: get user role: %s", op, "Couldn't find deleting user's role")
+			return "", fmt.Errorf("%s: get user role: %w", op, apierr.NotFound("Couldn't find deleting user's role"))
+		}
+		// peers holding the same power cannot delete each other
+		peer, err := r.roleHas(tx, delRole, authz.UsersDeleteAny)
+		if err != nil {
+			return "", fmt.Errorf("%s: check target role: %w", op, err)
 		}
-		if delRole == "admin" {
-			return fmt.Errorf("%s: check role: %s", op, "Admin cannot delete admin")
+		if peer {
+			return "", fmt.Errorf("%s: check role: %w", op, apierr.PermissionDenied("Cannot delete a user with the same privileges"))
 		}
 	}
 
-	query, args, err := q.ToSql()
+	query, args, err := r.bd.
+		Update("users").
+		Set("deleted_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": delUserID, "deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+
+	res, err := tx.Exec(query, args...)
+	if err != nil {
+		return "", fmt.Errorf("%s: execute tx query: %w", op, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return "", fmt.Errorf("%s: mark user: %w", op, apierr.NotFound("User not found or already being deleted"))
+	}
+
+	sagaID := uuid.NewString()
+	query, args, err = r.bd.
+		Insert("deletion_sagas").
+		Columns("id", "user_id", "requested_by").
+		Values(sagaID, delUserID, userID).
+		ToSql()
 	if err != nil {
-		return fmt.Errorf("%s: create tx query: %w", op, err)
+		return "", fmt.Errorf("%s: create tx query: %w", op, err)
 	}
 
 	if _, err := tx.Exec(query, args...); err != nil {
-		return fmt.Errorf("%s: execute tx query: %w", op, err)
+		return "", fmt.Errorf("%s: start saga: %w", op, err)
 	}
 
 	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("%s: commit transaction: %w", op, err)
+		return "", fmt.Errorf("%s: commit transaction: %w", op, err)
 	}
 
-	return nil
+	return sagaID, nil
 }
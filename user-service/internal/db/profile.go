@@ -0,0 +1,123 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+type Profile struct {
+	ID          string         `db:"id"`
+	Name        string         `db:"name"`
+	Email       sql.NullString `db:"email"`
+	Role        string         `db:"role"`
+	Verified    bool           `db:"email_verified"`
+	TOTPEnabled bool           `db:"totp_enabled"`
+	DisplayName string         `db:"display_name"`
+	Bio         string         `db:"bio"`
+	CreatedAt   time.Time      `db:"created_at"`
+	UpdatedAt   time.Time      `db:"updated_at"`
+}
+
+var profileColumns = []string{
+	"id", "name", "email", "role", "email_verified", "totp_enabled",
+	"display_name", "bio", "created_at", "updated_at",
+}
+
+// ProfileUpdate holds the fields to change; nil means keep as is.
+type ProfileUpdate struct {
+	Name        *string
+	Email       *string
+	DisplayName *string
+	Bio         *string
+}
+
+func (r *Repo) GetUser(id string) (*Profile, error) {
+	const op = "UserPostgresRepository.GetUser"
+
+	query, args, err := r.bd.
+		Select(profileColumns...).
+		From("users").
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	var data Profile
+	if err := r.db.Get(&data, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return &data, nil
+}
+
+// UpdateUser applies upd and resets email verification if the email
+// actually changed. The second result reports that change.
+func (r *Repo) UpdateUser(id string, upd ProfileUpdate) (*Profile, bool, error) {
+	const op = "UserPostgresRepository.UpdateUser"
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return nil, false, fmt.Errorf("%s: create transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := r.bd.
+		Select("email").
+		From("users").
+		Where(sq.Eq{"id": id}).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, false, fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+
+	var oldEmail sql.NullString
+	if err := tx.Get(&oldEmail, query, args...); err != nil {
+		return nil, false, fmt.Errorf("%s: execute tx query: %w", op, err)
+	}
+
+	q := r.bd.Update("users").Set("updated_at", time.Now())
+	// a name or email set by the user replaces the legacy login
+	if upd.Name != nil || upd.Email != nil {
+		q = q.Set("legacy_name", false)
+	}
+	if upd.Name != nil {
+		q = q.Set("name", *upd.Name)
+	}
+	if upd.DisplayName != nil {
+		q = q.Set("display_name", *upd.DisplayName)
+	}
+	if upd.Bio != nil {
+		q = q.Set("bio", *upd.Bio)
+	}
+
+	emailChanged := upd.Email != nil &&
+		!strings.EqualFold(*upd.Email, oldEmail.String)
+	if emailChanged {
+		q = q.Set("email", *upd.Email).Set("email_verified", false)
+	}
+
+	query, args, err = q.
+		Where(sq.Eq{"id": id}).
+		Suffix("RETURNING " + strings.Join(profileColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, false, fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+
+	var data Profile
+	if err := tx.Get(&data, query, args...); err != nil {
+		return nil, false, fmt.Errorf("%s: execute tx query: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, false, fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return &data, emailChanged, nil
+}
@@ -11,8 +11,12 @@ import (
 	"go.uber.org/zap"
 
 	gc "users/internal/graceful"
+
+	"github.com/Votline/3l1/protos/apierr"
 )
 
+var errSessionMismatch = apierr.InvalidCredentials("Session does not match token")
+
 type RedisRepo struct {
 	ctx context.Context
 	log *zap.Logger
@@ -40,21 +44,54 @@ func (r *RedisRepo) Stop(ctx context.Context) error {
 
 func (r *RedisRepo) NewSession(id, role string) (string, error) {
 	const op = "UserRedisRepository.NewSession"
-	
+
+	sk, err := r.newSession(id, role, "", time.Hour*720)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	return sk, nil
+}
+
+const ImpersonationTTL = time.Hour
+
+// NewImpersonation opens a short session for actorID acting as id.
+// It is indexed under id, so revoking the target's sessions ends it too.
+func (r *RedisRepo) NewImpersonation(id, role, actorID string) (string, error) {
+	const op = "UserRedisRepository.NewImpersonation"
+
+	sk, err := r.newSession(id, role, actorID, ImpersonationTTL)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	return sk, nil
+}
+
+func (r *RedisRepo) newSession(id, role, actorID string, ttl time.Duration) (string, error) {
+	const op = "UserRedisRepository.newSession"
+
 	sk := uuid.NewString()
 	tx := r.rdb.TxPipeline()
 
 	if err := tx.HSet(r.ctx, sk, map[string]string{
 		"id":   id,
 		"role": role,
+		"act":  actorID,
 	}).Err(); err != nil {
 		return "", fmt.Errorf("%s: tx add entry: %w", op, err)
 	}
 
-	if err := tx.Expire(r.ctx, sk, time.Hour*720).Err(); err != nil {
+	if err := tx.Expire(r.ctx, sk, ttl).Err(); err != nil {
 		return "", fmt.Errorf("%s: tx expire entry: %w", op, err)
 	}
 
+	if err := tx.SAdd(r.ctx, "sessions:"+id, sk).Err(); err != nil {
+		return "", fmt.Errorf("%s: tx index entry: %w", op, err)
+	}
+
+	if err := tx.Expire(r.ctx, "sessions:"+id, time.Hour*720).Err(); err != nil {
+		return "", fmt.Errorf("%s: tx expire index: %w", op, err)
+	}
+
 	if _, err := tx.Exec(r.ctx); err != nil {
 		return "", fmt.Errorf("%s: new session: %w", op, err)
 	}
@@ -62,7 +99,7 @@ func (r *RedisRepo) NewSession(id, role string) (string, error) {
 	return sk, nil
 }
 
-func (r *RedisRepo) Validate(id, role, sk string) error {
+func (r *RedisRepo) Validate(id, role, actorID, sk string) error {
 	const op = "UserRedisRepository.Validate"
 
 	fields, err := r.rdb.HGetAll(r.ctx, sk).Result()
@@ -72,11 +109,150 @@ func (r *RedisRepo) Validate(id, role, sk string) error {
 	if fields["id"] != id || fields["role"] != role {
 		err := fmt.Errorf("Data are different: %s: %s | %s: %s",
 			id, fields["id"], role, fields["role"])
-		return fmt.Errorf("%s: match data: %w", op, err)
+		return fmt.Errorf("%s: match data: %v: %w", op, err, errSessionMismatch)
+	}
+	// a regular session cannot refresh an impersonation token and back
+	if fields["act"] != actorID {
+		err := fmt.Errorf("Actor is different: %s | %s", actorID, fields["act"])
+		return fmt.Errorf("%s: match actor: %v: %w", op, err, errSessionMismatch)
 	}
 	return nil
 }
 
+const verifyTTL = 24 * time.Hour
+
+func (r *RedisRepo) NewVerification(id string) (string, error) {
+	const op = "UserRedisRepository.NewVerification"
+
+	token := uuid.NewString()
+	if err := r.rdb.Set(r.ctx, "verify:"+token, id, verifyTTL).Err(); err != nil {
+		return "", fmt.Errorf("%s: set entry: %w", op, err)
+	}
+
+	return token, nil
+}
+
+// resendInterval is how long a user waits between verification mails.
+const resendInterval = time.Minute
+
+var errResendTooSoon = apierr.Limited("Verification email was sent recently, try again in a minute")
+
+// ThrottleVerification lets one resend through per resendInterval.
+func (r *RedisRepo) ThrottleVerification(id string) error {
+	const op = "UserRedisRepository.ThrottleVerification"
+
+	ok, err := r.rdb.SetNX(r.ctx, "verify:resend:"+id, 1, resendInterval).Result()
+	if err != nil {
+		return fmt.Errorf("%s: set entry: %w", op, err)
+	}
+	if !ok {
+		return fmt.Errorf("%s: %w", op, errResendTooSoon)
+	}
+
+	return nil
+}
+
+func (r *RedisRepo) UseVerification(token string) (string, error) {
+	const op = "UserRedisRepository.UseVerification"
+
+	id, err := r.rdb.GetDel(r.ctx, "verify:"+token).Result()
+	if err != nil {
+		return "", fmt.Errorf("%s: get entry: %w", op, err)
+	}
+
+	return id, nil
+}
+
+const (
+	mfaTTL         = 5 * time.Minute
+	mfaMaxAttempts = 5
+)
+
+type MFAChallenge struct {
+	ID       string
+	Role     string
+	Verified bool
+}
+
+func (r *RedisRepo) NewMFAChallenge(c MFAChallenge) (string, error) {
+	const op = "UserRedisRepository.NewMFAChallenge"
+
+	token := uuid.NewString()
+	key := "mfa:" + token
+	tx := r.rdb.TxPipeline()
+
+	if err := tx.HSet(r.ctx, key, map[string]any{
+		"id":       c.ID,
+		"role":     c.Role,
+		"verified": c.Verified,
+		"attempts": 0,
+	}).Err(); err != nil {
+		return "", fmt.Errorf("%s: tx add entry: %w", op, err)
+	}
+
+	if err := tx.Expire(r.ctx, key, mfaTTL).Err(); err != nil {
+		return "", fmt.Errorf("%s: tx expire entry: %w", op, err)
+	}
+
+	if _, err := tx.Exec(r.ctx); err != nil {
+		return "", fmt.Errorf("%s: new challenge: %w", op, err)
+	}
+
+	return token, nil
+}
+
+// GetMFAChallenge counts every lookup as an attempt and drops the
+// challenge once mfaMaxAttempts is exceeded.
+func (r *RedisRepo) GetMFAChallenge(token string) (*MFAChallenge, error) {
+	const op = "UserRedisRepository.GetMFAChallenge"
+
+	key := "mfa:" + token
+	attempts, err := r.rdb.HIncrBy(r.ctx, key, "attempts", 1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("%s: count attempt: %w", op, err)
+	}
+	if attempts > mfaMaxAttempts {
+		r.rdb.Del(r.ctx, key)
+		return nil, fmt.Errorf("%s: check attempts: %w", op, apierr.Limited("Too many attempts"))
+	}
+
+	fields, err := r.rdb.HGetAll(r.ctx, key).Result()
+	if err != nil {
+		return nil, fmt.Errorf("%s: get all: %w", op, err)
+	}
+	if fields["id"] == "" {
+		r.rdb.Del(r.ctx, key)
+		return nil, fmt.Errorf("%s: match data: %w", op, apierr.InvalidCredentials("Challenge not found or expired"))
+	}
+
+	return &MFAChallenge{
+		ID:       fields["id"],
+		Role:     fields["role"],
+		Verified: fields["verified"] == "1",
+	}, nil
+}
+
+func (r *RedisRepo) DelMFAChallenge(token string) error {
+	const op = "UserRedisRepository.DelMFAChallenge"
+
+	if err := r.rdb.Del(r.ctx, "mfa:"+token).Err(); err != nil {
+		return fmt.Errorf("%s: delete challenge: %w", op, err)
+	}
+	return nil
+}
+
+// UseTOTPStep reports false if the step was already used for this user.
+func (r *RedisRepo) UseTOTPStep(id string, step int64) (bool, error) {
+	const op = "UserRedisRepository.UseTOTPStep"
+
+	key := fmt.Sprintf("totp:%s:%d", id, step)
+	ok, err := r.rdb.SetNX(r.ctx, key, 1, mfaTTL).Result()
+	if err != nil {
+		return false, fmt.Errorf("%s: set entry: %w", op, err)
+	}
+	return ok, nil
+}
+
 func (r *RedisRepo) DelSession(sk string) error {
 	const op = "UserRedisRepository.DelSession"
 
@@ -85,3 +261,83 @@ func (r *RedisRepo) DelSession(sk string) error {
 	}
 	return nil
 }
+
+type Session struct {
+	// Key is shortened, the full key is a credential
+	Key       string    `json:"key"`
+	Actor     string    `json:"impersonated_by,omitempty"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// ListSessions returns the live sessions of the user, skipping index
+// entries whose session has already expired.
+func (r *RedisRepo) ListSessions(id string) ([]Session, error) {
+	const op = "UserRedisRepository.ListSessions"
+
+	keys, err := r.rdb.SMembers(r.ctx, "sessions:"+id).Result()
+	if err != nil {
+		return nil, fmt.Errorf("%s: get members: %w", op, err)
+	}
+
+	sessions := make([]Session, 0, len(keys))
+	for _, sk := range keys {
+		fields, err := r.rdb.HGetAll(r.ctx, sk).Result()
+		if err != nil {
+			return nil, fmt.Errorf("%s: get all: %w", op, err)
+		}
+		if fields["id"] != id {
+			continue
+		}
+		ttl, err := r.rdb.TTL(r.ctx, sk).Result()
+		if err != nil {
+			return nil, fmt.Errorf("%s: get ttl: %w", op, err)
+		}
+		sessions = append(sessions, Session{
+			Key:       sk[:8],
+			Actor:     fields["act"],
+			ExpiresAt: time.Now().Add(ttl).UTC(),
+		})
+	}
+
+	return sessions, nil
+}
+
+// DelUserSessions drops every session of the user, forcing a new login.
+func (r *RedisRepo) DelUserSessions(id string) error {
+	const op = "UserRedisRepository.DelUserSessions"
+
+	keys, err := r.rdb.SMembers(r.ctx, "sessions:"+id).Result()
+	if err != nil {
+		return fmt.Errorf("%s: get members: %w", op, err)
+	}
+
+	if err := r.rdb.Del(r.ctx, append(keys, "sessions:"+id)...).Err(); err != nil {
+		return fmt.Errorf("%s: delete sessions: %w", op, err)
+	}
+	return nil
+}
+
+// RevokeToken puts a jti on the denylist until the token would have
+// expired anyway.
+func (r *RedisRepo) RevokeToken(jti string, exp time.Time) error {
+	const op = "UserRedisRepository.RevokeToken"
+
+	ttl := time.Until(exp)
+	if ttl <= 0 {
+		return nil
+	}
+	if err := r.rdb.Set(r.ctx, "revoked:"+jti, 1, ttl).Err(); err != nil {
+		return fmt.Errorf("%s: set entry: %w", op, err)
+	}
+	return nil
+}
+
+func (r *RedisRepo) IsRevoked(jti string) (bool, error) {
+	const op = "UserRedisRepository.IsRevoked"
+
+	n, err := r.rdb.Exists(r.ctx, "revoked:"+jti).Result()
+	if err != nil {
+		return false, fmt.Errorf("%s: check entry: %w", op, err)
+	}
+	return n > 0, nil
+}
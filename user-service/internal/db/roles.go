@@ -0,0 +1,126 @@
+package db
+
+import (
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Votline/3l1/protos/apierr"
+)
+
+func (r *Repo) SetUserRole(actorID, targetID, role string) error {
+	const op = "UserPostgresRepository.SetUserRole"
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("%s: create transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	oldRole, err := r.setRole(tx, targetID, role)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := r.addAudit(tx, actorID, "user.role.set", targetID, map[string]any{
+		"from": oldRole,
+		"to":   role,
+	}); err != nil {
+		return fmt.Errorf("%s: add audit: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return nil
+}
+
+// BootstrapAdmin promotes the user with the given email to admin, but
+// only while no admin exists yet.
+func (r *Repo) BootstrapAdmin(email string) (string, error) {
+	const op = "UserPostgresRepository.BootstrapAdmin"
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return "", fmt.Errorf("%s: create transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	// serialize concurrent bootstraps
+	if _, err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext('bootstrap-admin'))"); err != nil {
+		return "", fmt.Errorf("%s: lock: %w", op, err)
+	}
+
+	query, args, err := r.bd.
+		Select("count(*)").
+		From("users").
+		Where(sq.Eq{"role": "admin"}).
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+
+	var admins int
+	if err := tx.Get(&admins, query, args...); err != nil {
+		return "", fmt.Errorf("%s: execute tx query: %w", op, err)
+	}
+	if admins > 0 {
+		return "", fmt.Errorf("%s: check admins: %w", op, apierr.Conflict("Admin already exists"))
+	}
+
+	query, args, err = r.bd.
+		Select("id").
+		From("users").
+		Where("lower(email) = lower(?)", email).
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+
+	var id string
+	if err := tx.Get(&id, query, args...); err != nil {
+		return "", fmt.Errorf("%s: execute tx query: %w", op, err)
+	}
+
+	oldRole, err := r.setRole(tx, id, "admin")
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := r.addAudit(tx, "bootstrap", "user.role.set", id, map[string]any{
+		"from": oldRole,
+		"to":   "admin",
+	}); err != nil {
+		return "", fmt.Errorf("%s: add audit: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return id, nil
+}
+
+func (r *Repo) setRole(tx *sqlx.Tx, id, role string) (string, error) {
+	oldRole, err := r.getUserRole(id, tx)
+	if err != nil {
+		return "", fmt.Errorf("get user role: %w", err)
+	}
+
+	query, args, err := r.bd.
+		Update("users").
+		Set("role", role).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("create tx query: %w", err)
+	}
+
+	if _, err := tx.Exec(query, args...); err != nil {
+		return "", fmt.Errorf("execute tx query: %w", err)
+	}
+
+	return oldRole, nil
+}
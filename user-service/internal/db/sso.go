@@ -0,0 +1,132 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+// GetFederated returns the user linked to the provider subject.
+func (r *Repo) GetFederated(provider, subject string) (*User, error) {
+	const op = "UserPostgresRepository.GetFederated"
+
+	data, err := r.scanUser(r.bd.
+		Select("u.id", "u.role", "u.pswd", "u.email_verified", "u.totp_enabled").
+		From("federated_identities f").
+		Join("users u ON u.id = f.user_id").
+		Where(sq.Eq{"f.provider": provider, "f.subject": subject, "u.deleted_at": nil}))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return data, nil
+}
+
+func (r *Repo) LinkFederated(provider, subject, userID, email string) error {
+	const op = "UserPostgresRepository.LinkFederated"
+
+	query, args, err := r.bd.
+		Insert("federated_identities").
+		Columns("provider", "subject", "user_id", "email").
+		Values(provider, subject, userID, email).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create query: %w", op, err)
+	}
+
+	if _, err := r.db.Exec(query, args...); err != nil {
+		return fmt.Errorf("%s: execute query: %w", op, err)
+	}
+
+	return nil
+}
+
+// ProvisionFederated creates a user vouched for by the IdP, email
+// already verified, together with its identity link.
+func (r *Repo) ProvisionFederated(id, name, email, role, pswd, provider, subject string) error {
+	const op = "UserPostgresRepository.ProvisionFederated"
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("%s: create transaction: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var mail any
+	if email != "" {
+		mail = email
+	}
+
+	query, args, err := r.bd.
+		Insert("users").
+		Columns("id", "name", "email", "role", "pswd", "email_verified").
+		Values(id, name, mail, role, pswd, email != "").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+	if _, err := tx.Exec(query, args...); err != nil {
+		return fmt.Errorf("%s: insert user: %w", op, err)
+	}
+
+	query, args, err = r.bd.
+		Insert("federated_identities").
+		Columns("provider", "subject", "user_id", "email").
+		Values(provider, subject, id, mail).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: create tx query: %w", op, err)
+	}
+	if _, err := tx.Exec(query, args...); err != nil {
+		return fmt.Errorf("%s: insert identity: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: commit transaction: %w", op, err)
+	}
+
+	return nil
+}
+
+const ssoStateTTL = 10 * time.Minute
+
+type SSOState struct {
+	Provider string
+	Nonce    string
+	Verifier string
+}
+
+func (r *RedisRepo) NewSSOState(s SSOState) (string, error) {
+	const op = "UserRedisRepository.NewSSOState"
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("%s: marshal state: %w", op, err)
+	}
+
+	state := uuid.NewString()
+	if err := r.rdb.Set(r.ctx, "sso:state:"+state, data, ssoStateTTL).Err(); err != nil {
+		return "", fmt.Errorf("%s: set entry: %w", op, err)
+	}
+
+	return state, nil
+}
+
+func (r *RedisRepo) UseSSOState(state string) (*SSOState, error) {
+	const op = "UserRedisRepository.UseSSOState"
+
+	data, err := r.rdb.GetDel(r.ctx, "sso:state:"+state).Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("%s: get entry: %w", op, err)
+	}
+
+	var s SSOState
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("%s: unmarshal state: %w", op, err)
+	}
+
+	return &s, nil
+}
@@ -0,0 +1,20 @@
+package mailer
+
+import (
+	"os"
+
+	"go.uber.org/zap"
+)
+
+type Mailer interface {
+	Send(to, subject, body string) error
+}
+
+func New(log *zap.Logger) Mailer {
+	switch os.Getenv("MAILER") {
+	case "smtp":
+		return NewSMTP(log)
+	default:
+		return NewStub(log)
+	}
+}
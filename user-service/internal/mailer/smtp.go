@@ -0,0 +1,51 @@
+package mailer
+
+import (
+	"fmt"
+	"net/smtp"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+type smtpMailer struct {
+	log  *zap.Logger
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+func NewSMTP(log *zap.Logger) Mailer {
+	host := os.Getenv("SMTP_HOST")
+	m := &smtpMailer{
+		log:  log,
+		addr: host + ":" + os.Getenv("SMTP_PORT"),
+		from: os.Getenv("SMTP_FROM"),
+	}
+	if user := os.Getenv("SMTP_USER"); user != "" {
+		m.auth = smtp.PlainAuth("", user, os.Getenv("SMTP_PSWD"), host)
+	}
+	return m
+}
+
+func (m *smtpMailer) Send(to, subject, body string) error {
+	const op = "SMTPMailer.Send"
+
+	msg := strings.Join([]string{
+		"From: " + m.from,
+		"To: " + to,
+		"Subject: " + subject,
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=\"utf-8\"",
+		"",
+		body,
+	}, "\r\n")
+
+	if err := smtp.SendMail(m.addr, m.auth, m.from, []string{to}, []byte(msg)); err != nil {
+		return fmt.Errorf("%s: send mail: %w", op, err)
+	}
+
+	m.log.Debug("Mail sent", zap.String("to", to), zap.String("subject", subject))
+	return nil
+}
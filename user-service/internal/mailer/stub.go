@@ -0,0 +1,19 @@
+package mailer
+
+import "go.uber.org/zap"
+
+type stubMailer struct {
+	log *zap.Logger
+}
+
+func NewStub(log *zap.Logger) Mailer {
+	return &stubMailer{log: log}
+}
+
+func (m *stubMailer) Send(to, subject, body string) error {
+	m.log.Info("Stub mail",
+		zap.String("to", to),
+		zap.String("subject", subject),
+		zap.String("body", body))
+	return nil
+}
@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"net/http"
+	"os"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+)
+
+var (
+	FailedLogins = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "users_failed_logins_total",
+		Help: "Total number of failed login attempts",
+	}, []string{"reason"})
+
+	Lockouts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "users_lockouts_total",
+		Help: "Total number of temporary lockouts",
+	}, []string{"scope"})
+
+	DeletionSagas = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "users_deletion_sagas_total",
+		Help: "Total number of user deletion saga steps by outcome",
+	}, []string{"state"})
+)
+
+func Serve(log *zap.Logger) *http.Server {
+	port := os.Getenv("US_METRICS_PORT")
+	if port == "" {
+		port = "9101"
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	srv := &http.Server{Addr: ":" + port, Handler: mux}
+
+	go func() {
+		log.Debug("Metrics server starting on " + srv.Addr)
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Error("Metrics server failed", zap.Error(err))
+		}
+	}()
+
+	return srv
+}
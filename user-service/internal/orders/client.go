@@ -0,0 +1,38 @@
+// Package orders is the user-service side of the order-service API,
+// used to export and erase a user's orders.
+package orders
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	gc "users/internal/graceful"
+
+	pb "github.com/Votline/3l1/protos/generated-order"
+)
+
+type Client struct {
+	pb.OrderServiceClient
+	conn *grpc.ClientConn
+}
+
+func New() (*Client, error) {
+	const op = "orders.New"
+
+	conn, err := grpc.NewClient(
+		os.Getenv("OS_HOST")+":"+os.Getenv("OS_PORT"),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("%s: dial order-service: %w", op, err)
+	}
+
+	return &Client{OrderServiceClient: pb.NewOrderServiceClient(conn), conn: conn}, nil
+}
+
+func (c *Client) Stop(ctx context.Context) error {
+	return gc.Shutdown(c.conn.Close, ctx)
+}
@@ -0,0 +1,66 @@
+package pwpolicy
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+const prefixLen = 5
+
+// Breached holds SHA-1 hashes of leaked passwords bucketed by their
+// 5 character prefix, the same split the Pwned Passwords range API uses.
+// Lines are "<SHA1 hex>[:count]"; blank lines and # comments are skipped.
+type Breached struct {
+	ranges map[string][]string
+}
+
+func LoadBreached(path string) (*Breached, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	b := &Breached{ranges: make(map[string][]string)}
+
+	sc := bufio.NewScanner(f)
+	line := 0
+	for sc.Scan() {
+		line++
+		s := strings.TrimSpace(sc.Text())
+		if s == "" || strings.HasPrefix(s, "#") {
+			continue
+		}
+
+		hash, _, _ := strings.Cut(s, ":")
+		if len(hash) != sha1.Size*2 {
+			return nil, fmt.Errorf("line %d: not a sha-1 hash", line)
+		}
+		hash = strings.ToUpper(hash)
+
+		prefix := hash[:prefixLen]
+		b.ranges[prefix] = append(b.ranges[prefix], hash[prefixLen:])
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, r := range b.ranges {
+		slices.Sort(r)
+	}
+
+	return b, nil
+}
+
+func (b *Breached) Contains(pswd string) bool {
+	sum := sha1.Sum([]byte(pswd))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	_, found := slices.BinarySearch(b.ranges[hash[:prefixLen]], hash[prefixLen:])
+	return found
+}
@@ -0,0 +1,124 @@
+package pwpolicy
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type Policy struct {
+	MinLen     int
+	MaxLen     int
+	MinClasses int
+	// name and email local part may not appear in the password
+	NoIdentity bool
+	breached   *Breached
+}
+
+// Load reads PASSWORD_* variables and the breached-password list.
+// An unset PASSWORD_BREACHED_FILE disables the breach check.
+func Load() (*Policy, error) {
+	p := &Policy{
+		MinLen:     envInt("PASSWORD_MIN_LEN", 8),
+		MaxLen:     envInt("PASSWORD_MAX_LEN", 128),
+		MinClasses: envInt("PASSWORD_MIN_CLASSES", 3),
+		NoIdentity: os.Getenv("PASSWORD_ALLOW_IDENTITY") != "true",
+	}
+
+	if path := os.Getenv("PASSWORD_BREACHED_FILE"); path != "" {
+		b, err := LoadBreached(path)
+		if err != nil {
+			return nil, fmt.Errorf("load breached list: %w", err)
+		}
+		p.breached = b
+	}
+
+	return p, nil
+}
+
+func envInt(key string, def int) int {
+	if n, err := strconv.Atoi(os.Getenv(key)); err == nil && n >= 0 {
+		return n
+	}
+	return def
+}
+
+// Violation lists every rule the password broke.
+type Violation []string
+
+func (v Violation) Error() string {
+	return "Weak password: " + strings.Join(v, "; ")
+}
+
+func (p *Policy) Check(pswd, name, email string) error {
+	var v Violation
+
+	n := utf8.RuneCountInString(pswd)
+	if n < p.MinLen {
+		v = append(v, fmt.Sprintf("must be at least %d characters", p.MinLen))
+	}
+	if p.MaxLen > 0 && n > p.MaxLen {
+		v = append(v, fmt.Sprintf("must be at most %d characters", p.MaxLen))
+	}
+
+	if c := classes(pswd); c < p.MinClasses {
+		v = append(v, fmt.Sprintf(
+			"must mix at least %d of lowercase, uppercase, digits and symbols", p.MinClasses))
+	}
+
+	if p.NoIdentity && containsIdentity(pswd, name, email) {
+		v = append(v, "must not contain the user name or email")
+	}
+
+	if p.breached != nil && p.breached.Contains(pswd) {
+		v = append(v, "appears in a known data breach")
+	}
+
+	if len(v) > 0 {
+		return v
+	}
+	return nil
+}
+
+func classes(s string) int {
+	var lower, upper, digit, other bool
+	for _, r := range s {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			other = true
+		}
+	}
+
+	n := 0
+	for _, ok := range []bool{lower, upper, digit, other} {
+		if ok {
+			n++
+		}
+	}
+	return n
+}
+
+func containsIdentity(pswd, name, email string) bool {
+	pswd = strings.ToLower(pswd)
+
+	local, _, _ := strings.Cut(email, "@")
+	for _, s := range []string{name, local} {
+		// too short to be meaningful, "al" would reject half of all passwords
+		if len(s) < 3 {
+			continue
+		}
+		if strings.Contains(pswd, strings.ToLower(s)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"users/internal/crypto"
+	"users/internal/db"
+
+	"github.com/Votline/3l1/protos/apierr"
+	"github.com/Votline/3l1/protos/authz"
+	pb "github.com/Votline/3l1/protos/generated-user"
+)
+
+// inviteOnly closes open registration: RegUser then needs an invite code
+// and SSO logins cannot provision new users.
+func inviteOnly() bool {
+	return os.Getenv("INVITE_ONLY") == "true"
+}
+
+func (us *userserver) CreateInvite(ctx context.Context, req *pb.CreateInviteReq) (*pb.CreateInviteRes, error) {
+	const op = "UserService.CreateInvite"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	perms := authz.New(req.GetPermissions())
+	if !perms.Has(authz.UsersInvite) {
+		return nil, fmt.Errorf("%s: check permission: %w", op, apierr.PermissionDenied("Not allowed to create invites"))
+	}
+	// an invite for another role is a role assignment in advance
+	if req.GetInviteRole() != us.defRole && !perms.Has(authz.UsersRoleSet) {
+		return nil, fmt.Errorf("%s: check permission: %w", op, apierr.PermissionDenied("Not allowed to invite with this role"))
+	}
+	if err := us.checkRole(req.GetInviteRole()); err != nil {
+		return nil, fmt.Errorf("%s: check role: %w", op, err)
+	}
+
+	code, err := crypto.GenInviteCode()
+	if err != nil {
+		return nil, fmt.Errorf("%s: generate code: %w", op, err)
+	}
+
+	inv := &db.Invite{
+		ID:        uuid.NewString(),
+		Role:      req.GetInviteRole(),
+		Note:      req.GetNote(),
+		MaxUses:   req.GetMaxUses(),
+		ExpiresAt: time.Now().Add(time.Duration(req.GetTtlHours()) * time.Hour).UTC(),
+		CreatedBy: req.GetUserId(),
+	}
+	if err := us.repo.AddInvite(inv, crypto.HashCode(code)); err != nil {
+		return nil, fmt.Errorf("%s: add invite: %w", op, err)
+	}
+
+	us.log.Info("Created invite",
+		zap.String("op", op),
+		zap.String("invite id", inv.ID),
+		zap.String("role", inv.Role),
+		zap.String("created by", inv.CreatedBy))
+
+	return &pb.CreateInviteRes{Invite: toInvite(inv), Code: code}, nil
+}
+
+func (us *userserver) ListInvites(ctx context.Context, req *pb.ListInvitesReq) (*pb.ListInvitesRes, error) {
+	const op = "UserService.ListInvites"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	perms := authz.New(req.GetPermissions())
+	if !perms.Has(authz.UsersInvite) {
+		return nil, fmt.Errorf("%s: check permission: %w", op, apierr.PermissionDenied("Not allowed to list invites"))
+	}
+
+	invites, err := us.repo.ListInvites(req.GetAll())
+	if err != nil {
+		return nil, fmt.Errorf("%s: list invites: %w", op, err)
+	}
+
+	res := &pb.ListInvitesRes{Invites: make([]*pb.Invite, 0, len(invites))}
+	for i := range invites {
+		res.Invites = append(res.Invites, toInvite(&invites[i]))
+	}
+
+	return res, nil
+}
+
+func (us *userserver) ListRedemptions(ctx context.Context, req *pb.ListRedemptionsReq) (*pb.ListRedemptionsRes, error) {
+	const op = "UserService.ListRedemptions"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	perms := authz.New(req.GetPermissions())
+	if !perms.Has(authz.UsersInvite) {
+		return nil, fmt.Errorf("%s: check permission: %w", op, apierr.PermissionDenied("Not allowed to list redemptions"))
+	}
+
+	redemptions, err := us.repo.ListRedemptions(req.GetInviteId())
+	if err != nil {
+		return nil, fmt.Errorf("%s: list redemptions: %w", op, err)
+	}
+
+	res := &pb.ListRedemptionsRes{Redemptions: make([]*pb.Redemption, 0, len(redemptions))}
+	for _, r := range redemptions {
+		res.Redemptions = append(res.Redemptions, &pb.Redemption{
+			UserId:     r.UserID,
+			Name:       r.Name,
+			RedeemedAt: timestamppb.New(r.RedeemedAt),
+		})
+	}
+
+	return res, nil
+}
+
+func (us *userserver) RevokeInvite(ctx context.Context, req *pb.RevokeInviteReq) (*pb.RevokeInviteRes, error) {
+	const op = "UserService.RevokeInvite"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	perms := authz.New(req.GetPermissions())
+	if !perms.Has(authz.UsersInvite) {
+		return nil, fmt.Errorf("%s: check permission: %w", op, apierr.PermissionDenied("Not allowed to revoke invites"))
+	}
+
+	if err := us.repo.RevokeInvite(req.GetUserId(), req.GetInviteId()); err != nil {
+		return nil, fmt.Errorf("%s: revoke invite: %w", op, err)
+	}
+
+	return &pb.RevokeInviteRes{}, nil
+}
+
+func toInvite(inv *db.Invite) *pb.Invite {
+	res := &pb.Invite{
+		Id:        inv.ID,
+		Role:      inv.Role,
+		Note:      inv.Note,
+		MaxUses:   inv.MaxUses,
+		Uses:      inv.Uses,
+		ExpiresAt: timestamppb.New(inv.ExpiresAt),
+		CreatedBy: inv.CreatedBy,
+		CreatedAt: timestamppb.New(inv.CreatedAt),
+	}
+	if inv.RevokedAt.Valid {
+		res.RevokedAt = timestamppb.New(inv.RevokedAt.Time)
+	}
+	return res
+}
@@ -2,9 +2,12 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"flag"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -17,7 +20,14 @@ import (
 	"users/internal/crypto"
 	"users/internal/db"
 	gc "users/internal/graceful"
-
+	"users/internal/mailer"
+	"users/internal/metrics"
+	"users/internal/orders"
+	"users/internal/pwpolicy"
+	"users/internal/sso"
+
+	"github.com/Votline/3l1/protos/apierr"
+	"github.com/Votline/3l1/protos/authz"
 	pb "github.com/Votline/3l1/protos/generated-user"
 	"github.com/google/uuid"
 )
@@ -26,34 +36,87 @@ type userserver struct {
 	log       *zap.Logger
 	repo      *db.Repo
 	redisRepo *db.RedisRepo
+	mailer    mailer.Mailer
+	attempts  db.AttemptPolicy
+	defRole   string
+	policy    *pwpolicy.Policy
+	signer    *crypto.Signer
+	providers map[string]*sso.Provider
+	orders    *orders.Client
+	sagas     db.SagaPolicy
 	pb.UnimplementedUserServiceServer
 }
 
 func main() {
 	log, _ := zap.NewDevelopment()
+
+	bootstrap := flag.String("bootstrap-admin", "",
+		"promote the user with this email to admin if no admin exists, then exit")
+	flag.Parse()
+	if *bootstrap != "" {
+		bootstrapAdmin(*bootstrap, log)
+		return
+	}
+
 	lis, err := net.Listen("tcp", ":"+os.Getenv("US_PORT"))
 	if err != nil {
 		log.Fatal("Couldn't listen tcp user-service port", zap.Error(err))
 	}
 
-	s := grpc.NewServer()
+	pswdPolicy, err := pwpolicy.Load()
+	if err != nil {
+		log.Fatal("Couldn't load password policy", zap.Error(err))
+	}
+
+	signer, ephemeral, err := crypto.LoadSigner()
+	if err != nil {
+		log.Fatal("Couldn't load OAuth signing key", zap.Error(err))
+	}
+	if ephemeral {
+		log.Warn("OAUTH_SIGNING_KEY not set, OAuth tokens will not survive a restart")
+	}
+
+	ordersClient, err := orders.New()
+	if err != nil {
+		log.Fatal("Couldn't create order-service client", zap.Error(err))
+	}
+
+	s := grpc.NewServer(grpc.UnaryInterceptor(apierr.UnaryServerInterceptor(
+		func(ctx context.Context, method string, err error) {
+			log.Error("Rpc failed",
+				zap.String("method", method),
+				zap.Error(err))
+		})))
 	srv := userserver{
 		log:       log,
 		repo:      db.NewRepo(log),
 		redisRepo: db.NewRR(log),
+		mailer:    mailer.New(log),
+		attempts:  db.LoadAttemptPolicy(),
+		defRole:   defaultRole(),
+		policy:    pswdPolicy,
+		signer:    signer,
+		providers: sso.Load(log),
+		orders:    ordersClient,
+		sagas:     db.LoadSagaPolicy(),
 	}
 	pb.RegisterUserServiceServer(s, &srv)
 
 	go s.Serve(lis)
+	ms := metrics.Serve(log)
+
+	sagaCtx, stopSagas := context.WithCancel(context.Background())
+	go srv.runDeletions(sagaCtx)
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM, os.Interrupt)
 	<-quit
 	log.Warn("Shutdown signal received")
-	gracefulShutdown(s, srv, log)
+	stopSagas()
+	gracefulShutdown(s, ms, srv, log)
 }
 
-func gracefulShutdown(s *grpc.Server, srv userserver, log *zap.Logger) {
+func gracefulShutdown(s *grpc.Server, ms *http.Server, srv userserver, log *zap.Logger) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -63,6 +126,16 @@ func gracefulShutdown(s *grpc.Server, srv userserver, log *zap.Logger) {
 		log.Error("gRPC server shutdown error", zap.Error(err))
 	}
 
+	log.Info("Shutting down metrics server")
+	if err := gc.Shutdown(ms.Close, ctx); err != nil {
+		log.Error("Metrics server shutdown error", zap.Error(err))
+	}
+
+	log.Info("Shutting down order-service client")
+	if err := srv.orders.Stop(ctx); err != nil {
+		log.Error("Order-service client shutdown error", zap.Error(err))
+	}
+
 	log.Info("Shutting down postgreSQL")
 	if err := srv.repo.Stop(ctx); err != nil {
 		log.Error("Postgres shutdown error", zap.Error(err))
@@ -83,16 +156,51 @@ func (us *userserver) RegUser(ctx context.Context, req *pb.RegReq) (*pb.RegRes,
 
 	name := req.GetName()
 	email := req.GetEmail()
-	role := req.GetRole()
+	role := us.defRole
 	pswd := req.GetPassword()
 	id := uuid.New().String()
 
+	if inviteOnly() && req.GetInviteCode() == "" {
+		return nil, fmt.Errorf("%s: check invite: %w", op, apierr.PermissionDenied("Registration requires an invite code"))
+	}
+
+	if err := us.policy.Check(pswd, name, email); err != nil {
+		return nil, fmt.Errorf("%s: check password: %w", op, err)
+	}
+
 	hashed, err := crypto.Hash(pswd)
 	if err != nil {
 		return nil, fmt.Errorf("%s: hash password: %w", op, err)
 	}
 
-	token, err := crypto.GenJWT(id, role)
+	if code := req.GetInviteCode(); code != "" {
+		role, err = us.repo.AddInvitedUser(id, name, email, hashed, crypto.HashCode(code))
+	} else {
+		err = us.repo.AddUser(id, name, email, role, hashed)
+	}
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			if pqErr.Constraint == "users_name_key" {
+				return nil, fmt.Errorf("%s: %w", op, apierr.Conflict(
+					"user with this username already exists").WithReason(apierr.ReasonUsernameTaken))
+			}
+			return nil, fmt.Errorf("%s: %w", op, apierr.Conflict(
+				"user with this email already exists").WithReason(apierr.ReasonEmailTaken))
+		}
+		return nil, fmt.Errorf("%s: add user: %w", op, err)
+	}
+
+	perms, err := us.repo.RolePermissions(role)
+	if err != nil {
+		return nil, fmt.Errorf("%s: get permissions: %w", op, err)
+	}
+
+	token, err := crypto.GenJWT(crypto.UserInfo{
+		UserID: id,
+		Role:   role,
+		Perms:  perms,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("%s: generate jwt: %w", op, err)
 	}
@@ -102,17 +210,38 @@ func (us *userserver) RegUser(ctx context.Context, req *pb.RegReq) (*pb.RegRes,
 		return nil, fmt.Errorf("%s: new session: %w", op, err)
 	}
 
-	if err := us.repo.AddUser(id, name+email, role, hashed); err != nil {
-		var pqErr *pq.Error
-		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
-			return nil, fmt.Errorf("%s: user with this email or username already exists", op)
-		}
-		return nil, fmt.Errorf("%s: add user: %w", op, err)
+	if err := us.sendVerification(id, email); err != nil {
+		us.log.Error("Failed to send verification email",
+			zap.String("op", op),
+			zap.String("user id", id),
+			zap.Error(err))
 	}
 
 	return &pb.RegRes{Token: token, SessionKey: sessionKey}, nil
 }
 
+func (us *userserver) sendVerification(id, email string) error {
+	const op = "UserService.sendVerification"
+
+	token, err := us.redisRepo.NewVerification(id)
+	if err != nil {
+		return fmt.Errorf("%s: new verification: %w", op, err)
+	}
+
+	body := "Confirm your email address by opening the link below:\n\n" +
+		os.Getenv("VERIFY_URL") + token + "\n\n" +
+		"The link expires in 24 hours."
+	if err := us.mailer.Send(email, "Confirm your email", body); err != nil {
+		return fmt.Errorf("%s: send mail: %w", op, err)
+	}
+
+	return nil
+}
+
+// errBadLogin is the same for an unknown account and a wrong password,
+// telling them apart would tell which accounts exist.
+var errBadLogin = apierr.InvalidCredentials("Invalid name, email or password")
+
 func (us *userserver) LogUser(ctx context.Context, req *pb.LogReq) (*pb.LogRes, error) {
 	const op = "UserService.LogUser"
 
@@ -123,29 +252,156 @@ func (us *userserver) LogUser(ctx context.Context, req *pb.LogReq) (*pb.LogRes,
 	name := req.GetName()
 	email := req.GetEmail()
 	pswd := req.GetPassword()
+	ip := req.GetClientIp()
 
-	data, err := us.repo.LogUser(name+email, pswd)
+	if name == "" && email == "" {
+		return nil, fmt.Errorf("%s validate: %w", op, apierr.Invalid("name or email required"))
+	}
+
+	if err := us.redisRepo.CheckLogin("", ip); err != nil {
+		metrics.FailedLogins.WithLabelValues("locked").Inc()
+		return nil, fmt.Errorf("%s: check login: %w", op, err)
+	}
+
+	data, err := us.repo.LogUser(name, email)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			us.failLogin("", ip, "unknown_user")
+			return nil, fmt.Errorf("%s: login user: %v: %w", op, err, errBadLogin)
+		}
 		return nil, fmt.Errorf("%s: login user: %w", op, err)
 	}
 
+	if err := us.redisRepo.CheckLogin(data.ID, ""); err != nil {
+		metrics.FailedLogins.WithLabelValues("locked").Inc()
+		return nil, fmt.Errorf("%s: check login: %w", op, err)
+	}
+
 	if !crypto.CheckPswd(data.Pswd, pswd) {
-		return nil, fmt.Errorf("%s: check password: %s", op, "Invalid password")
+		us.failLogin(data.ID, ip, "invalid_password")
+		return nil, fmt.Errorf("%s: check password: %w", op, errBadLogin)
 	}
 
-	sessionKey, err := us.redisRepo.NewSession(data.ID, data.Role)
+	if err := us.redisRepo.ResetLogin(data.ID); err != nil {
+		us.log.Error("Failed to reset login attempts",
+			zap.String("op", op),
+			zap.String("user id", data.ID),
+			zap.Error(err))
+	}
+
+	if crypto.NeedsRehash(data.Pswd) {
+		us.rehash(data.ID, data.Pswd, pswd)
+	}
+
+	if data.Legacy {
+		if err := us.repo.SplitLegacyName(data.ID, name, email); err != nil {
+			us.log.Error("Failed to split legacy user name",
+				zap.String("op", op),
+				zap.String("user id", data.ID),
+				zap.Error(err))
+		}
+	}
+
+	res, err := us.completeLogin(data)
 	if err != nil {
-		return nil, fmt.Errorf("%s: new session: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, nil
+}
+
+// completeLogin runs the steps shared by every first factor: an MFA
+// challenge when the user or role needs one, otherwise a new session.
+func (us *userserver) completeLogin(data *db.User) (*pb.LogRes, error) {
+	required, err := us.repo.IsMFARequired(data.Role)
+	if err != nil {
+		return nil, fmt.Errorf("check mfa role: %w", err)
+	}
+	if data.TOTPEnabled || required {
+		mfaToken, err := us.redisRepo.NewMFAChallenge(db.MFAChallenge{
+			ID:       data.ID,
+			Role:     data.Role,
+			Verified: data.Verified,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("new mfa challenge: %w", err)
+		}
+		return &pb.LogRes{
+			MfaRequired:      true,
+			MfaToken:         mfaToken,
+			MfaEnrolRequired: !data.TOTPEnabled,
+		}, nil
 	}
 
-	token, err := crypto.GenJWT(data.ID, data.Role)
+	token, sessionKey, err := us.issue(data.ID, data.Role, data.Verified)
 	if err != nil {
-		return nil, fmt.Errorf("%s: generate jwt: %w", op, err)
+		return nil, err
 	}
 
 	return &pb.LogRes{Token: token, SessionKey: sessionKey}, nil
 }
 
+func (us *userserver) rehash(id, oldHash, pswd string) {
+	hashed, err := crypto.Hash(pswd)
+	if err != nil {
+		us.log.Error("Failed to rehash password",
+			zap.String("user id", id),
+			zap.Error(err))
+		return
+	}
+
+	if err := us.repo.RehashPassword(id, oldHash, hashed); err != nil {
+		us.log.Error("Failed to store rehashed password",
+			zap.String("user id", id),
+			zap.Error(err))
+	}
+}
+
+func (us *userserver) failLogin(id, ip, reason string) {
+	metrics.FailedLogins.WithLabelValues(reason).Inc()
+
+	locked, err := us.redisRepo.FailLogin(id, ip, us.attempts)
+	if err != nil {
+		us.log.Error("Failed to record login attempt",
+			zap.String("user id", id),
+			zap.String("ip", ip),
+			zap.Error(err))
+		return
+	}
+
+	for _, scope := range locked {
+		metrics.Lockouts.WithLabelValues(scope).Inc()
+		us.log.Warn("Login locked",
+			zap.String("scope", scope),
+			zap.String("user id", id),
+			zap.String("ip", ip))
+	}
+}
+
+func (us *userserver) issue(id, role string, verified bool) (string, string, error) {
+	sessionKey, err := us.redisRepo.NewSession(id, role)
+	if err != nil {
+		return "", "", fmt.Errorf("new session: %w", err)
+	}
+
+	perms, err := us.repo.RolePermissions(role)
+	if err != nil {
+		return "", "", fmt.Errorf("get permissions: %w", err)
+	}
+
+	token, err := crypto.GenJWT(crypto.UserInfo{
+		UserID:   id,
+		Role:     role,
+		Verified: verified,
+		Perms:    perms,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("generate jwt: %w", err)
+	}
+
+	return token, sessionKey, nil
+}
+
 func (us *userserver) ExtJWTData(ctx context.Context, req *pb.ExtJWTDataReq) (*pb.ExtJWTDataRes, error) {
 	const op = "UserService.ExtJWTData"
 
@@ -159,10 +415,22 @@ func (us *userserver) ExtJWTData(ctx context.Context, req *pb.ExtJWTDataReq) (*p
 	data, err := crypto.ExtJWT(tokenString)
 	id, role := data.UserID, data.Role
 	if id != "" && role != "" && err == nil {
-		if err := us.redisRepo.Validate(id, role, sk); err != nil {
+		if err := us.checkRevoked(data.ID); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if err := us.redisRepo.Validate(id, role, data.Actor, sk); err != nil {
 			return nil, fmt.Errorf("%s: validate: %w", op, err)
 		}
-		tokenString, err = crypto.GenJWT(id, role)
+		// the claim goes stale when the email changes, so ask the db
+		data.Verified, err = us.repo.IsVerified(id)
+		if err != nil {
+			return nil, fmt.Errorf("%s: check verified: %w", op, err)
+		}
+		data.Perms, err = us.repo.RolePermissions(role)
+		if err != nil {
+			return nil, fmt.Errorf("%s: get permissions: %w", op, err)
+		}
+		tokenString, err = crypto.GenJWT(data)
 		if err != nil {
 			return nil, fmt.Errorf("%s: generate jwt: %w", op, err)
 		}
@@ -171,9 +439,12 @@ func (us *userserver) ExtJWTData(ctx context.Context, req *pb.ExtJWTDataReq) (*p
 	}
 
 	return &pb.ExtJWTDataRes{
-		Role:   data.Role,
-		UserId: data.UserID,
-		Token:  tokenString,
+		Role:          data.Role,
+		UserId:        data.UserID,
+		Token:         tokenString,
+		EmailVerified: data.Verified,
+		Permissions:   data.Perms,
+		ActorId:       data.Actor,
 	}, nil
 }
 
@@ -184,18 +455,95 @@ func (us *userserver) DelUser(ctx context.Context, req *pb.DelUserReq) (*pb.DelU
 		return nil, fmt.Errorf("%s validate: %w", op, err)
 	}
 
-	role := req.GetRole()
 	userID := req.GetUserId()
 	delUserID := req.GetDelUserId()
 	sk := req.GetSessionKey()
 
+	perms := authz.New(req.GetPermissions())
+
 	if err := us.redisRepo.DelSession(sk); err != nil {
 		return nil, fmt.Errorf("%s: delete session: %w", op, err)
 	}
 
-	if err := us.repo.DelUser(userID, role, delUserID); err != nil {
+	sagaID, err := us.repo.DelUser(userID, delUserID, perms)
+	if err != nil {
 		return nil, fmt.Errorf("%s: delete user: %w", op, err)
 	}
 
-	return &pb.DelUserRes{}, nil
+	// orders and the row itself are handled by the deletion saga
+	if err := us.redisRepo.DelUserSessions(delUserID); err != nil {
+		us.log.Error("Failed to drop sessions of deleted user",
+			zap.String("op", op),
+			zap.String("user id", delUserID),
+			zap.Error(err))
+	}
+
+	return &pb.DelUserRes{DeletionId: sagaID}, nil
+}
+
+func (us *userserver) VerifyEmail(ctx context.Context, req *pb.VerifyEmailReq) (*pb.VerifyEmailRes, error) {
+	const op = "UserService.VerifyEmail"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	id, err := us.redisRepo.UseVerification(req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("%s: use verification: %w", op, err)
+	}
+
+	if err := us.repo.SetVerified(id); err != nil {
+		return nil, fmt.Errorf("%s: set verified: %w", op, err)
+	}
+
+	return &pb.VerifyEmailRes{}, nil
+}
+
+func (us *userserver) ResendVerification(ctx context.Context, req *pb.ResendVerificationReq) (*pb.ResendVerificationRes, error) {
+	const op = "UserService.ResendVerification"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	data, err := us.repo.GetUser(req.GetUserId())
+	if err != nil {
+		return nil, fmt.Errorf("%s: get user: %w", op, err)
+	}
+	if data.Verified {
+		return nil, fmt.Errorf("%s: %w", op, apierr.Precondition("Email is already verified"))
+	}
+	// legacy accounts may have no email until they set one with PATCH /me
+	if !data.Email.Valid {
+		return nil, fmt.Errorf("%s: %w", op, apierr.Precondition("No email address to verify, set one first"))
+	}
+
+	if err := us.redisRepo.ThrottleVerification(data.ID); err != nil {
+		return nil, fmt.Errorf("%s: throttle: %w", op, err)
+	}
+	if err := us.sendVerification(data.ID, data.Email.String); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &pb.ResendVerificationRes{}, nil
+}
+
+func (us *userserver) UnlockUser(ctx context.Context, req *pb.UnlockUserReq) (*pb.UnlockUserRes, error) {
+	const op = "UserService.UnlockUser"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	perms := authz.New(req.GetPermissions())
+	if !perms.Has(authz.UsersUnlock) {
+		return nil, fmt.Errorf("%s: check permission: %w", op, apierr.PermissionDenied("Not allowed to unlock users"))
+	}
+
+	if err := us.redisRepo.ResetLogin(req.GetUserId()); err != nil {
+		return nil, fmt.Errorf("%s: reset login: %w", op, err)
+	}
+
+	return &pb.UnlockUserRes{}, nil
 }
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"users/internal/crypto"
+	"users/internal/db"
+
+	"github.com/Votline/3l1/protos/apierr"
+	"github.com/Votline/3l1/protos/authz"
+	pb "github.com/Votline/3l1/protos/generated-user"
+)
+
+const recoveryCodes = 10
+
+func (us *userserver) VerifyMFA(ctx context.Context, req *pb.VerifyMFAReq) (*pb.VerifyMFARes, error) {
+	const op = "UserService.VerifyMFA"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	mfaToken := req.GetMfaToken()
+	ch, err := us.redisRepo.GetMFAChallenge(mfaToken)
+	if err != nil {
+		return nil, fmt.Errorf("%s: get challenge: %w", op, err)
+	}
+
+	data, err := us.repo.GetTOTP(ch.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: get totp: %w", op, err)
+	}
+	if !data.Enabled {
+		return nil, fmt.Errorf("%s: check totp: %w", op, apierr.Precondition("TOTP enrolment required"))
+	}
+
+	if err := us.checkSecondFactor(ch.ID, data.Secret.String, req.GetCode()); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := us.redisRepo.DelMFAChallenge(mfaToken); err != nil {
+		return nil, fmt.Errorf("%s: delete challenge: %w", op, err)
+	}
+
+	token, sessionKey, err := us.issue(ch.ID, ch.Role, ch.Verified)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &pb.VerifyMFARes{Token: token, SessionKey: sessionKey}, nil
+}
+
+func (us *userserver) checkSecondFactor(id, secret, code string) error {
+	if len(code) == 6 {
+		step, ok := crypto.CheckTOTP(secret, code, time.Now())
+		if !ok {
+			return fmt.Errorf("check totp: %w", apierr.InvalidCredentials("Invalid code"))
+		}
+		fresh, err := us.redisRepo.UseTOTPStep(id, step)
+		if err != nil {
+			return fmt.Errorf("use totp step: %w", err)
+		}
+		if !fresh {
+			return fmt.Errorf("check totp: %w", apierr.InvalidCredentials("Code already used"))
+		}
+		return nil
+	}
+
+	ok, err := us.repo.UseRecoveryCode(id, crypto.HashCode(code))
+	if err != nil {
+		return fmt.Errorf("use recovery code: %w", err)
+	}
+	if !ok {
+		return fmt.Errorf("check recovery code: %w", apierr.InvalidCredentials("Invalid code"))
+	}
+	return nil
+}
+
+// mfaSubject resolves the enrolling user either from an authenticated
+// request or from a pending login challenge.
+func (us *userserver) mfaSubject(userID, mfaToken string) (string, *db.MFAChallenge, error) {
+	if mfaToken != "" {
+		ch, err := us.redisRepo.GetMFAChallenge(mfaToken)
+		if err != nil {
+			return "", nil, fmt.Errorf("get challenge: %w", err)
+		}
+		return ch.ID, ch, nil
+	}
+	if userID == "" {
+		return "", nil, fmt.Errorf("resolve user: %w", apierr.Invalid("user_id or mfa_token required"))
+	}
+	return userID, nil, nil
+}
+
+func (us *userserver) EnrollTOTP(ctx context.Context, req *pb.EnrollTOTPReq) (*pb.EnrollTOTPRes, error) {
+	const op = "UserService.EnrollTOTP"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	id, _, err := us.mfaSubject(req.GetUserId(), req.GetMfaToken())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	data, err := us.repo.GetTOTP(id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: get totp: %w", op, err)
+	}
+
+	secret, err := crypto.GenTOTPSecret()
+	if err != nil {
+		return nil, fmt.Errorf("%s: generate secret: %w", op, err)
+	}
+
+	if err := us.repo.SetTOTPSecret(id, secret); err != nil {
+		return nil, fmt.Errorf("%s: set secret: %w", op, err)
+	}
+
+	issuer := os.Getenv("TOTP_ISSUER")
+	if issuer == "" {
+		issuer = "3l1"
+	}
+
+	return &pb.EnrollTOTPRes{
+		Secret:     secret,
+		OtpauthUri: crypto.TOTPURI(issuer, data.Account, secret),
+	}, nil
+}
+
+func (us *userserver) ConfirmTOTP(ctx context.Context, req *pb.ConfirmTOTPReq) (*pb.ConfirmTOTPRes, error) {
+	const op = "UserService.ConfirmTOTP"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	id, ch, err := us.mfaSubject(req.GetUserId(), req.GetMfaToken())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	data, err := us.repo.GetTOTP(id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: get totp: %w", op, err)
+	}
+	if data.Enabled {
+		return nil, fmt.Errorf("%s: check totp: %w", op, apierr.Conflict("TOTP is already enabled"))
+	}
+	if !data.Secret.Valid {
+		return nil, fmt.Errorf("%s: check totp: %w", op, apierr.Precondition("TOTP enrolment not started"))
+	}
+
+	if err := us.checkSecondFactor(id, data.Secret.String, req.GetCode()); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	codes, err := crypto.GenRecoveryCodes(recoveryCodes)
+	if err != nil {
+		return nil, fmt.Errorf("%s: generate recovery codes: %w", op, err)
+	}
+	hashes := make([]string, len(codes))
+	for i, c := range codes {
+		hashes[i] = crypto.HashCode(c)
+	}
+
+	if err := us.repo.EnableTOTP(id, hashes); err != nil {
+		return nil, fmt.Errorf("%s: enable totp: %w", op, err)
+	}
+
+	res := &pb.ConfirmTOTPRes{RecoveryCodes: codes}
+	if ch == nil {
+		return res, nil
+	}
+
+	// enrolment was forced during login, so finish the login here
+	if err := us.redisRepo.DelMFAChallenge(req.GetMfaToken()); err != nil {
+		return nil, fmt.Errorf("%s: delete challenge: %w", op, err)
+	}
+	res.Token, res.SessionKey, err = us.issue(ch.ID, ch.Role, ch.Verified)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, nil
+}
+
+func (us *userserver) SetMFARole(ctx context.Context, req *pb.SetMFARoleReq) (*pb.SetMFARoleRes, error) {
+	const op = "UserService.SetMFARole"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	perms := authz.New(req.GetPermissions())
+	if !perms.Has(authz.UsersMFAManage) {
+		return nil, fmt.Errorf("%s: check permission: %w", op, apierr.PermissionDenied("Not allowed to change MFA policy"))
+	}
+
+	if err := us.checkRole(req.GetTargetRole()); err != nil {
+		return nil, fmt.Errorf("%s: check role: %w", op, err)
+	}
+	if err := us.repo.SetMFARole(req.GetTargetRole(), req.GetRequired()); err != nil {
+		return nil, fmt.Errorf("%s: set mfa role: %w", op, err)
+	}
+
+	return &pb.SetMFARoleRes{}, nil
+}
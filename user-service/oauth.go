@@ -0,0 +1,442 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"users/internal/crypto"
+	"users/internal/db"
+
+	"github.com/Votline/3l1/protos/apierr"
+	"github.com/Votline/3l1/protos/authz"
+	pb "github.com/Votline/3l1/protos/generated-user"
+)
+
+const accessTokenTTL = 15 * time.Minute
+
+// oauthScopes lists what third-party clients may ask for and the
+// permissions each scope carries. OAuth tokens only ever act on the
+// user's own orders.
+var oauthScopes = map[string][]string{
+	"openid":       nil,
+	"profile":      nil,
+	"email":        nil,
+	"orders:read":  {authz.OrdersReadOwn},
+	"orders:write": {authz.OrdersCreate, authz.OrdersDeleteOwn},
+}
+
+func (us *userserver) RegisterClient(ctx context.Context, req *pb.RegisterClientReq) (*pb.RegisterClientRes, error) {
+	const op = "UserService.RegisterClient"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	for _, s := range req.GetScopes() {
+		if _, ok := oauthScopes[s]; !ok {
+			return nil, fmt.Errorf("%s: check scopes: %w", op, apierr.Invalid("Unknown scope: "+s))
+		}
+	}
+
+	c := &db.OAuthClient{
+		ID:           uuid.New().String(),
+		OwnerID:      req.GetUserId(),
+		Name:         req.GetName(),
+		RedirectURIs: req.GetRedirectUris(),
+		Scopes:       req.GetScopes(),
+	}
+
+	var secret string
+	if req.GetConfidential() {
+		var err error
+		if secret, err = crypto.GenSecret(); err != nil {
+			return nil, fmt.Errorf("%s: generate secret: %w", op, err)
+		}
+		c.SecretHash = sql.NullString{String: crypto.HashCode(secret), Valid: true}
+	}
+
+	if err := us.repo.AddClient(c); err != nil {
+		return nil, fmt.Errorf("%s: add client: %w", op, err)
+	}
+
+	return &pb.RegisterClientRes{Client: toClient(c), ClientSecret: secret}, nil
+}
+
+func (us *userserver) ListClients(ctx context.Context, req *pb.ListClientsReq) (*pb.ListClientsRes, error) {
+	const op = "UserService.ListClients"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	clients, err := us.repo.ListClients(req.GetUserId())
+	if err != nil {
+		return nil, fmt.Errorf("%s: list clients: %w", op, err)
+	}
+
+	res := &pb.ListClientsRes{Clients: make([]*pb.OAuthClient, len(clients))}
+	for i := range clients {
+		res.Clients[i] = toClient(&clients[i])
+	}
+
+	return res, nil
+}
+
+func (us *userserver) DeleteClient(ctx context.Context, req *pb.DeleteClientReq) (*pb.DeleteClientRes, error) {
+	const op = "UserService.DeleteClient"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	if err := us.repo.DelClient(req.GetUserId(), req.GetClientId()); err != nil {
+		return nil, fmt.Errorf("%s: delete client: %w", op, err)
+	}
+
+	return &pb.DeleteClientRes{}, nil
+}
+
+func (us *userserver) Authorize(ctx context.Context, req *pb.AuthorizeReq) (*pb.AuthorizeRes, error) {
+	const op = "UserService.Authorize"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	// a code would become tokens without the act claim and outlive the
+	// read-only impersonation
+	if req.GetActorId() != "" {
+		return nil, fmt.Errorf("%s: check actor: %w", op,
+			apierr.PermissionDenied("Cannot authorize clients while impersonating"))
+	}
+
+	client, err := us.repo.GetClient(req.GetClientId())
+	if err != nil {
+		return nil, fmt.Errorf("%s: get client: %w", op, apierr.Invalid("Unknown client"))
+	}
+	// never redirect anywhere the client did not register
+	if !slices.Contains(client.RedirectURIs, req.GetRedirectUri()) {
+		return nil, fmt.Errorf("%s: check redirect: %w", op, apierr.Invalid("Redirect URI not registered"))
+	}
+
+	scopes := strings.Fields(req.GetScope())
+	for _, s := range scopes {
+		if !slices.Contains(client.Scopes, s) {
+			return nil, fmt.Errorf("%s: check scopes: %w", op, apierr.Invalid("Scope not allowed for client: "+s))
+		}
+	}
+
+	userID := req.GetUserId()
+	if req.GetApprove() {
+		if err := us.repo.SaveConsent(userID, client.ID, scopes); err != nil {
+			return nil, fmt.Errorf("%s: save consent: %w", op, err)
+		}
+	} else {
+		granted, err := us.repo.GetConsent(userID, client.ID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: get consent: %w", op, err)
+		}
+		for _, s := range scopes {
+			if !slices.Contains(granted, s) {
+				return &pb.AuthorizeRes{
+					ConsentRequired: true,
+					ClientName:      client.Name,
+					Scopes:          scopes,
+				}, nil
+			}
+		}
+	}
+
+	code, err := us.redisRepo.NewAuthCode(db.AuthCode{
+		ClientID:    client.ID,
+		UserID:      userID,
+		RedirectURI: req.GetRedirectUri(),
+		Scopes:      scopes,
+		Challenge:   req.GetCodeChallenge(),
+		Nonce:       req.GetNonce(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: new auth code: %w", op, err)
+	}
+
+	redirect, err := url.Parse(req.GetRedirectUri())
+	if err != nil {
+		return nil, fmt.Errorf("%s: parse redirect: %w", op, err)
+	}
+	q := redirect.Query()
+	q.Set("code", code)
+	if req.GetState() != "" {
+		q.Set("state", req.GetState())
+	}
+	redirect.RawQuery = q.Encode()
+
+	return &pb.AuthorizeRes{
+		ClientName: client.Name,
+		Scopes:     scopes,
+		RedirectTo: redirect.String(),
+	}, nil
+}
+
+func (us *userserver) Token(ctx context.Context, req *pb.TokenReq) (*pb.TokenRes, error) {
+	const op = "UserService.Token"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	client, err := us.authClient(req.GetClientId(), req.GetClientSecret())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var userID string
+	var scopes []string
+	var nonce string
+
+	switch req.GetGrantType() {
+	case "authorization_code":
+		code, err := us.redisRepo.UseAuthCode(req.GetCode())
+		if err != nil {
+			return nil, fmt.Errorf("%s: use code: %w", op, apierr.InvalidCredentials("invalid_grant"))
+		}
+		if code.ClientID != client.ID || code.RedirectURI != req.GetRedirectUri() {
+			return nil, fmt.Errorf("%s: match code: %w", op, apierr.InvalidCredentials("invalid_grant"))
+		}
+		if !crypto.CheckPKCE(code.Challenge, req.GetCodeVerifier()) {
+			return nil, fmt.Errorf("%s: check pkce: %w", op, apierr.InvalidCredentials("invalid_grant"))
+		}
+		userID, scopes, nonce = code.UserID, code.Scopes, code.Nonce
+
+	case "client_credentials":
+		// public clients cannot keep a secret, so they cannot act alone
+		if !client.SecretHash.Valid {
+			return nil, fmt.Errorf("%s: check client: %w", op, apierr.PermissionDenied("unauthorized_client"))
+		}
+		scopes = strings.Fields(req.GetScope())
+		if len(scopes) == 0 {
+			scopes = client.Scopes
+		}
+		for _, s := range scopes {
+			if !slices.Contains(client.Scopes, s) {
+				return nil, fmt.Errorf("%s: check scopes: %w", op, apierr.Invalid("invalid_scope"))
+			}
+		}
+		// no user in this flow, the client acts as its owner
+		userID = client.OwnerID
+		scopes = slices.DeleteFunc(slices.Clone(scopes), func(s string) bool {
+			return oauthScopes[s] == nil
+		})
+	}
+
+	access, err := us.signer.Sign(jwt.MapClaims{
+		"sub":       userID,
+		"aud":       client.ID,
+		"client_id": client.ID,
+		"scope":     strings.Join(scopes, " "),
+		"jti":       uuid.NewString(),
+		"token_use": "access",
+	}, accessTokenTTL)
+	if err != nil {
+		return nil, fmt.Errorf("%s: sign access token: %w", op, err)
+	}
+
+	res := &pb.TokenRes{
+		AccessToken: access,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(accessTokenTTL.Seconds()),
+		Scope:       strings.Join(scopes, " "),
+	}
+
+	if slices.Contains(scopes, "openid") {
+		user, err := us.repo.GetUser(userID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: get user: %w", op, err)
+		}
+
+		claims := jwt.MapClaims{
+			"sub":       userID,
+			"aud":       client.ID,
+			"auth_time": time.Now().Unix(),
+			"token_use": "id",
+		}
+		if nonce != "" {
+			claims["nonce"] = nonce
+		}
+		userClaims(claims, user, scopes)
+
+		if res.IdToken, err = us.signer.Sign(claims, accessTokenTTL); err != nil {
+			return nil, fmt.Errorf("%s: sign id token: %w", op, err)
+		}
+	}
+
+	return res, nil
+}
+
+func (us *userserver) AuthAccessToken(ctx context.Context, req *pb.AuthAccessTokenReq) (*pb.AuthAccessTokenRes, error) {
+	const op = "UserService.AuthAccessToken"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	claims, user, err := us.accessToken(req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// scopes are capped by what the user's role still allows
+	perms, err := us.permsOf(user.Role)
+	if err != nil {
+		return nil, fmt.Errorf("%s: get permissions: %w", op, err)
+	}
+	var granted []string
+	for _, s := range strings.Fields(claims["scope"].(string)) {
+		for _, p := range oauthScopes[s] {
+			if grantsOwn(perms, p) {
+				granted = append(granted, p)
+			}
+		}
+	}
+
+	return &pb.AuthAccessTokenRes{
+		UserId:        user.ID,
+		Role:          user.Role,
+		EmailVerified: user.Verified,
+		Permissions:   granted,
+		ClientId:      claims["client_id"].(string),
+	}, nil
+}
+
+func (us *userserver) OAuthUserInfo(ctx context.Context, req *pb.OAuthUserInfoReq) (*pb.OAuthUserInfoRes, error) {
+	const op = "UserService.OAuthUserInfo"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	claims, user, err := us.accessToken(req.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	scopes := strings.Fields(claims["scope"].(string))
+	if !slices.Contains(scopes, "openid") {
+		return nil, fmt.Errorf("%s: check scopes: %w", op, apierr.PermissionDenied("insufficient_scope"))
+	}
+
+	res := &pb.OAuthUserInfoRes{Sub: user.ID, Scopes: scopes}
+	if slices.Contains(scopes, "profile") {
+		res.Name = user.Name
+	}
+	if slices.Contains(scopes, "email") {
+		res.Email = user.Email.String
+		res.EmailVerified = user.Verified
+	}
+
+	return res, nil
+}
+
+func (us *userserver) JWKS(ctx context.Context, req *pb.JWKSReq) (*pb.JWKSRes, error) {
+	const op = "UserService.JWKS"
+
+	jwks, err := us.signer.JWKS()
+	if err != nil {
+		return nil, fmt.Errorf("%s: build jwks: %w", op, err)
+	}
+
+	return &pb.JWKSRes{Jwks: jwks}, nil
+}
+
+// authClient checks the secret of confidential clients, public clients
+// are identified by their id alone.
+func (us *userserver) authClient(id, secret string) (*db.OAuthClient, error) {
+	client, err := us.repo.GetClient(id)
+	if err != nil {
+		return nil, fmt.Errorf("get client: %w", apierr.InvalidCredentials("invalid_client"))
+	}
+	if client.SecretHash.Valid {
+		hash := crypto.HashCode(secret)
+		if subtle.ConstantTimeCompare([]byte(hash), []byte(client.SecretHash.String)) != 1 {
+			return nil, fmt.Errorf("check secret: %w", apierr.InvalidCredentials("invalid_client"))
+		}
+	}
+	return client, nil
+}
+
+// accessToken verifies an OAuth access token and loads its user. Tokens
+// die with their client.
+func (us *userserver) accessToken(token string) (jwt.MapClaims, *db.Profile, error) {
+	claims, err := us.signer.Verify(token)
+	if err != nil {
+		return nil, nil, fmt.Errorf("verify token: %v: %w", err, apierr.InvalidCredentials("Invalid access token"))
+	}
+	if use, _ := claims["token_use"].(string); use != "access" {
+		return nil, nil, fmt.Errorf("check token: %w", apierr.InvalidCredentials("Not an access token"))
+	}
+	if _, ok := claims["scope"].(string); !ok {
+		return nil, nil, fmt.Errorf("check token: %w", apierr.PermissionDenied("Missing scope"))
+	}
+	jti, _ := claims["jti"].(string)
+	if err := us.checkRevoked(jti); err != nil {
+		return nil, nil, err
+	}
+
+	clientID, _ := claims["client_id"].(string)
+	if _, err := us.repo.GetClient(clientID); err != nil {
+		return nil, nil, fmt.Errorf("get client: %w", err)
+	}
+
+	sub, _ := claims["sub"].(string)
+	user, err := us.repo.GetUser(sub)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get user: %w", err)
+	}
+
+	return claims, user, nil
+}
+
+// grantsOwn reports whether perms cover p, where holding the :any form
+// of a scoped permission covers its :own form.
+func grantsOwn(perms authz.Set, p string) bool {
+	if perms.Has(p) {
+		return true
+	}
+	if base, ok := strings.CutSuffix(p, ":own"); ok {
+		return perms.Has(base + ":any")
+	}
+	return false
+}
+
+func userClaims(claims jwt.MapClaims, user *db.Profile, scopes []string) {
+	if slices.Contains(scopes, "profile") {
+		claims["name"] = user.Name
+		if user.DisplayName != "" {
+			claims["preferred_username"] = user.DisplayName
+		}
+	}
+	if slices.Contains(scopes, "email") && user.Email.Valid {
+		claims["email"] = user.Email.String
+		claims["email_verified"] = user.Verified
+	}
+}
+
+func toClient(c *db.OAuthClient) *pb.OAuthClient {
+	return &pb.OAuthClient{
+		Id:           c.ID,
+		Name:         c.Name,
+		RedirectUris: c.RedirectURIs,
+		Scopes:       c.Scopes,
+		Confidential: c.SecretHash.Valid,
+		CreatedAt:    timestamppb.New(c.CreatedAt),
+	}
+}
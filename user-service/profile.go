@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"users/internal/db"
+
+	"github.com/Votline/3l1/protos/apierr"
+	"github.com/Votline/3l1/protos/authz"
+	pb "github.com/Votline/3l1/protos/generated-user"
+)
+
+func (us *userserver) GetUser(ctx context.Context, req *pb.GetUserReq) (*pb.GetUserRes, error) {
+	const op = "UserService.GetUser"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	userID := req.GetUserId()
+	targetID := req.GetTargetId()
+	perms := authz.New(req.GetPermissions())
+	if !perms.CanOn(authz.UsersRead, userID == targetID) {
+		return nil, fmt.Errorf("%s: check permission: %w", op, apierr.PermissionDenied("Not allowed to read this user"))
+	}
+
+	data, err := us.repo.GetUser(targetID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: get user: %w", op, err)
+	}
+
+	return &pb.GetUserRes{User: toProfile(data)}, nil
+}
+
+func (us *userserver) UpdateUser(ctx context.Context, req *pb.UpdateUserReq) (*pb.UpdateUserRes, error) {
+	const op = "UserService.UpdateUser"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	id := req.GetUserId()
+	data, emailChanged, err := us.repo.UpdateUser(id, db.ProfileUpdate{
+		Name:        req.Name,
+		Email:       req.Email,
+		DisplayName: req.DisplayName,
+		Bio:         req.Bio,
+	})
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			if pqErr.Constraint == "users_name_key" {
+				return nil, fmt.Errorf("%s: %w", op, apierr.Conflict(
+					"user with this username already exists").WithReason(apierr.ReasonUsernameTaken))
+			}
+			return nil, fmt.Errorf("%s: %w", op, apierr.Conflict(
+				"user with this email already exists").WithReason(apierr.ReasonEmailTaken))
+		}
+		return nil, fmt.Errorf("%s: update user: %w", op, err)
+	}
+
+	if emailChanged {
+		if err := us.sendVerification(id, data.Email.String); err != nil {
+			us.log.Error("Failed to send verification email",
+				zap.String("op", op),
+				zap.String("user id", id),
+				zap.Error(err))
+		}
+	}
+
+	return &pb.UpdateUserRes{User: toProfile(data)}, nil
+}
+
+const defaultListLimit = 50
+
+func (us *userserver) ListUsers(ctx context.Context, req *pb.ListUsersReq) (*pb.ListUsersRes, error) {
+	const op = "UserService.ListUsers"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	perms := authz.New(req.GetPermissions())
+	if !perms.Has(authz.UsersList) {
+		return nil, fmt.Errorf("%s: check permission: %w", op, apierr.PermissionDenied("Not allowed to list users"))
+	}
+	if role := req.GetRoleFilter(); role != "" {
+		if err := us.checkRole(role); err != nil {
+			return nil, fmt.Errorf("%s: check role: %w", op, err)
+		}
+	}
+
+	f := db.UserFilter{
+		Query:  req.GetQuery(),
+		Role:   req.GetRoleFilter(),
+		Cursor: req.GetCursor(),
+		Limit:  uint64(req.GetLimit()),
+	}
+	if f.Limit == 0 {
+		f.Limit = defaultListLimit
+	}
+	if req.CreatedFrom != nil {
+		f.CreatedFrom = req.GetCreatedFrom().AsTime()
+	}
+	if req.CreatedTo != nil {
+		f.CreatedTo = req.GetCreatedTo().AsTime()
+	}
+
+	users, next, err := us.repo.ListUsers(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: list users: %w", op, err)
+	}
+
+	res := &pb.ListUsersRes{
+		Users:      make([]*pb.UserProfile, len(users)),
+		NextCursor: next,
+	}
+	for i := range users {
+		res.Users[i] = toProfile(&users[i])
+	}
+
+	return res, nil
+}
+
+func toProfile(p *db.Profile) *pb.UserProfile {
+	return &pb.UserProfile{
+		Id:            p.ID,
+		Name:          p.Name,
+		Email:         p.Email.String,
+		Role:          p.Role,
+		EmailVerified: p.Verified,
+		TotpEnabled:   p.TOTPEnabled,
+		DisplayName:   p.DisplayName,
+		Bio:           p.Bio,
+		CreatedAt:     timestamppb.New(p.CreatedAt),
+		UpdatedAt:     timestamppb.New(p.UpdatedAt),
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.uber.org/zap"
+
+	"users/internal/db"
+
+	"github.com/Votline/3l1/protos/apierr"
+	"github.com/Votline/3l1/protos/authz"
+	pb "github.com/Votline/3l1/protos/generated-user"
+)
+
+func defaultRole() string {
+	if role := os.Getenv("DEFAULT_ROLE"); role == "dev" || role == "guest" {
+		return role
+	}
+	return "guest"
+}
+
+// permsOf resolves the role through the db on every call so edits to
+// role_permissions take effect without reissuing tokens.
+func (us *userserver) permsOf(role string) (authz.Set, error) {
+	perms, err := us.repo.RolePermissions(role)
+	if err != nil {
+		return nil, err
+	}
+	return authz.New(perms), nil
+}
+
+// checkRole rejects roles without rows in role_permissions, the table is
+// the only list of roles there is.
+func (us *userserver) checkRole(role string) error {
+	perms, err := us.repo.RolePermissions(role)
+	if err != nil {
+		return err
+	}
+	if len(perms) == 0 {
+		return apierr.NotFound("Unknown role")
+	}
+	return nil
+}
+
+func bootstrapAdmin(email string, log *zap.Logger) {
+	repo := db.NewRepo(log)
+	defer repo.Stop(context.Background())
+
+	id, err := repo.BootstrapAdmin(email)
+	if err != nil {
+		log.Fatal("Failed to bootstrap admin", zap.Error(err))
+	}
+
+	log.Info("Promoted user to admin",
+		zap.String("user id", id),
+		zap.String("email", email))
+}
+
+func (us *userserver) SetUserRole(ctx context.Context, req *pb.SetUserRoleReq) (*pb.SetUserRoleRes, error) {
+	const op = "UserService.SetUserRole"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	userID := req.GetUserId()
+	targetID := req.GetTargetId()
+	perms := authz.New(req.GetPermissions())
+	if !perms.Has(authz.UsersRoleSet) {
+		return nil, fmt.Errorf("%s: check permission: %w", op, apierr.PermissionDenied("Not allowed to change roles"))
+	}
+	if userID == targetID {
+		return nil, fmt.Errorf("%s: match id's: %w", op, apierr.PermissionDenied("Admin cannot change own role"))
+	}
+
+	if err := us.checkRole(req.GetNewRole()); err != nil {
+		return nil, fmt.Errorf("%s: check role: %w", op, err)
+	}
+
+	if err := us.repo.SetUserRole(userID, targetID, req.GetNewRole()); err != nil {
+		return nil, fmt.Errorf("%s: set role: %w", op, err)
+	}
+
+	// sessions carry the old role, make the user log in again
+	if err := us.redisRepo.DelUserSessions(targetID); err != nil {
+		us.log.Error("Failed to drop sessions after role change",
+			zap.String("op", op),
+			zap.String("user id", targetID),
+			zap.Error(err))
+	}
+
+	return &pb.SetUserRoleRes{}, nil
+}
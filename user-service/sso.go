@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+
+	"users/internal/crypto"
+	"users/internal/db"
+	"users/internal/sso"
+
+	"github.com/Votline/3l1/protos/apierr"
+	pb "github.com/Votline/3l1/protos/generated-user"
+)
+
+func (us *userserver) SSOStart(ctx context.Context, req *pb.SSOStartReq) (*pb.SSOStartRes, error) {
+	const op = "UserService.SSOStart"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	p, ok := us.providers[req.GetProvider()]
+	if !ok {
+		return nil, fmt.Errorf("%s: find provider: %w", op, apierr.NotFound("Unknown identity provider"))
+	}
+
+	verifier, err := crypto.GenSecret()
+	if err != nil {
+		return nil, fmt.Errorf("%s: generate verifier: %w", op, err)
+	}
+	nonce := uuid.NewString()
+
+	state, err := us.redisRepo.NewSSOState(db.SSOState{
+		Provider: p.Name,
+		Nonce:    nonce,
+		Verifier: verifier,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: new state: %w", op, err)
+	}
+
+	authURL, err := p.AuthCodeURL(ctx, state, nonce, crypto.PKCEChallenge(verifier))
+	if err != nil {
+		return nil, fmt.Errorf("%s: build auth url: %w", op, err)
+	}
+
+	return &pb.SSOStartRes{AuthUrl: authURL, State: state}, nil
+}
+
+func (us *userserver) SSOCallback(ctx context.Context, req *pb.SSOCallbackReq) (*pb.LogRes, error) {
+	const op = "UserService.SSOCallback"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	p, ok := us.providers[req.GetProvider()]
+	if !ok {
+		return nil, fmt.Errorf("%s: find provider: %w", op, apierr.NotFound("Unknown identity provider"))
+	}
+
+	st, err := us.redisRepo.UseSSOState(req.GetState())
+	if err != nil || st.Provider != p.Name {
+		return nil, fmt.Errorf("%s: check state: %w", op, apierr.InvalidCredentials("Invalid or expired state"))
+	}
+
+	claims, err := p.Exchange(ctx, req.GetCode(), st.Verifier, st.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("%s: exchange code: %v: %w", op, err,
+			apierr.InvalidCredentials("Identity provider did not confirm the login"))
+	}
+
+	data, err := us.repo.GetFederated(p.Name, claims.Subject)
+	if errors.Is(err, sql.ErrNoRows) {
+		data, err = us.federate(p, claims)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	res, err := us.completeLogin(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, nil
+}
+
+// federate links a first-time external identity to the user owning the
+// same verified email, or provisions a new user for it.
+func (us *userserver) federate(p *sso.Provider, c *sso.Claims) (*db.User, error) {
+	email := ""
+	if c.Verified {
+		email = c.Email
+	}
+
+	if email != "" {
+		existing, err := us.repo.LogUser("", email)
+		switch {
+		case err == nil:
+			// an unverified local email may belong to someone squatting it
+			if !existing.Verified {
+				return nil, fmt.Errorf("link identity: %w",
+					apierr.Precondition("Account with this email is not verified, log in with password and verify it first"))
+			}
+			if err := us.repo.LinkFederated(p.Name, c.Subject, existing.ID, email); err != nil {
+				return nil, fmt.Errorf("link identity: %w", err)
+			}
+			us.log.Info("Linked external identity",
+				zap.String("provider", p.Name),
+				zap.String("user id", existing.ID))
+			return existing, nil
+		case !errors.Is(err, sql.ErrNoRows):
+			return nil, fmt.Errorf("find user by email: %w", err)
+		}
+	}
+
+	if inviteOnly() {
+		return nil, fmt.Errorf("provision user: %w",
+			apierr.PermissionDenied("Registration is invite-only, sign up with an invite code first"))
+	}
+
+	role := us.defRole
+	if p.DefaultRole == "dev" || p.DefaultRole == "guest" {
+		role = p.DefaultRole
+	}
+
+	// nobody knows this password, the account logs in through the IdP
+	secret, err := crypto.GenSecret()
+	if err != nil {
+		return nil, fmt.Errorf("generate password: %w", err)
+	}
+	hashed, err := crypto.Hash(secret)
+	if err != nil {
+		return nil, fmt.Errorf("hash password: %w", err)
+	}
+
+	id := uuid.New().String()
+	base := ssoName(c)
+	name := base
+	for i := 0; ; i++ {
+		err = us.repo.ProvisionFederated(id, name, email, role, hashed, p.Name, c.Subject)
+		var pqErr *pq.Error
+		if i < 5 && errors.As(err, &pqErr) && pqErr.Constraint == "users_name_key" {
+			suffix, _ := crypto.GenSecret()
+			name = base + "-" + suffix[:6]
+			continue
+		}
+		break
+	}
+	if err != nil {
+		return nil, fmt.Errorf("provision user: %w", err)
+	}
+
+	us.log.Info("Provisioned user from external identity",
+		zap.String("provider", p.Name),
+		zap.String("user id", id),
+		zap.String("role", role))
+
+	return &db.User{ID: id, Role: role, Verified: email != ""}, nil
+}
+
+var nameChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+func ssoName(c *sso.Claims) string {
+	name := c.Name
+	if name == "" {
+		name, _, _ = strings.Cut(c.Email, "@")
+	}
+	name = nameChars.ReplaceAllString(name, "")
+	if len(name) > 40 {
+		name = name[:40]
+	}
+	if len(name) < 2 {
+		name = "user"
+	}
+	return name
+}
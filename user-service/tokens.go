@@ -0,0 +1,203 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	"users/internal/crypto"
+
+	"github.com/Votline/3l1/protos/apierr"
+	"github.com/Votline/3l1/protos/authz"
+	pb "github.com/Votline/3l1/protos/generated-user"
+)
+
+// tokenInfo is what introspection and revocation need to know about a
+// token, whichever of our two kinds it is.
+type tokenInfo struct {
+	Type      string
+	ID        string
+	Sub       string
+	ClientID  string
+	Scope     string
+	Issuer    string
+	Role      string
+	Actor     string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+func (us *userserver) IntrospectToken(ctx context.Context, req *pb.IntrospectReq) (*pb.IntrospectRes, error) {
+	const op = "UserService.IntrospectToken"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	client, err := us.authClient(req.GetClientId(), req.GetClientSecret())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	// only resource servers ask, and those can keep a secret
+	if !client.SecretHash.Valid {
+		return nil, fmt.Errorf("%s: check client: %w", op, apierr.PermissionDenied("unauthorized_client"))
+	}
+
+	info, err := us.parseToken(req.GetToken(), req.GetTokenTypeHint())
+	if err != nil {
+		// the reason stays with us, the caller only learns it is dead
+		us.log.Debug("Inactive token introspected",
+			zap.String("client id", client.ID), zap.Error(err))
+		return &pb.IntrospectRes{Active: false}, nil
+	}
+
+	return &pb.IntrospectRes{
+		Active:    true,
+		TokenType: info.Type,
+		Sub:       info.Sub,
+		ClientId:  info.ClientID,
+		Scope:     info.Scope,
+		Exp:       info.ExpiresAt.Unix(),
+		Iat:       info.IssuedAt.Unix(),
+		Jti:       info.ID,
+		Iss:       info.Issuer,
+		Role:      info.Role,
+		Act:       info.Actor,
+	}, nil
+}
+
+func (us *userserver) RevokeToken(ctx context.Context, req *pb.RevokeTokenReq) (*pb.RevokeTokenRes, error) {
+	const op = "UserService.RevokeToken"
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s validate: %w", op, err)
+	}
+
+	info, err := us.parseToken(req.GetToken(), req.GetTokenTypeHint())
+	if err != nil {
+		// invalid, expired and already revoked tokens need nothing done
+		return &pb.RevokeTokenRes{}, nil
+	}
+	if info.ID == "" {
+		return nil, fmt.Errorf("%s: check token: %w", op, apierr.Invalid("unsupported_token_type"))
+	}
+
+	var by string
+	switch {
+	case req.GetClientId() != "":
+		client, err := us.authClient(req.GetClientId(), req.GetClientSecret())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if info.ClientID != client.ID {
+			return nil, fmt.Errorf("%s: check client: %w", op, apierr.PermissionDenied("unauthorized_client"))
+		}
+		by = "client:" + client.ID
+
+	case req.GetUserId() != "":
+		perms := authz.New(req.GetPermissions())
+		if !perms.CanOn(authz.TokensRevoke, info.Sub == req.GetUserId()) {
+			return nil, fmt.Errorf("%s: check permission: %w", op, apierr.PermissionDenied("Not allowed to revoke this token"))
+		}
+		by = req.GetUserId()
+
+	default:
+		return nil, fmt.Errorf("%s: check caller: %w", op, apierr.Invalid("Client or user required"))
+	}
+
+	if err := us.redisRepo.RevokeToken(info.ID, info.ExpiresAt); err != nil {
+		return nil, fmt.Errorf("%s: revoke token: %w", op, err)
+	}
+
+	us.log.Info("Token revoked",
+		zap.String("jti", info.ID),
+		zap.String("type", info.Type),
+		zap.String("sub", info.Sub),
+		zap.String("revoked by", by))
+
+	return &pb.RevokeTokenRes{}, nil
+}
+
+// parseToken accepts login JWTs and OAuth access tokens that are still
+// valid, trying the hinted kind first.
+func (us *userserver) parseToken(token, hint string) (*tokenInfo, error) {
+	parsers := []func(string) (*tokenInfo, error){us.sessionToken, us.oauthToken}
+	if hint == "access_token" {
+		slices.Reverse(parsers)
+	}
+
+	var errs []error
+	for _, parse := range parsers {
+		info, err := parse(token)
+		if err == nil {
+			return info, nil
+		}
+		errs = append(errs, err)
+	}
+	return nil, errors.Join(errs...)
+}
+
+func (us *userserver) sessionToken(token string) (*tokenInfo, error) {
+	data, err := crypto.ExtJWT(token)
+	if err != nil {
+		return nil, fmt.Errorf("extract jwt: %w", err)
+	}
+	if err := us.checkRevoked(data.ID); err != nil {
+		return nil, err
+	}
+
+	return &tokenInfo{
+		Type:      "session_token",
+		ID:        data.ID,
+		Sub:       data.UserID,
+		Role:      data.Role,
+		Actor:     data.Actor,
+		IssuedAt:  data.IssuedAt,
+		ExpiresAt: data.ExpiresAt,
+	}, nil
+}
+
+func (us *userserver) oauthToken(token string) (*tokenInfo, error) {
+	claims, user, err := us.accessToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &tokenInfo{
+		Type: "access_token",
+		Sub:  user.ID,
+		Role: user.Role,
+	}
+	info.ID, _ = claims["jti"].(string)
+	info.ClientID, _ = claims["client_id"].(string)
+	info.Scope, _ = claims["scope"].(string)
+	info.Issuer, _ = claims["iss"].(string)
+	if iat, err := claims.GetIssuedAt(); err == nil && iat != nil {
+		info.IssuedAt = iat.Time
+	}
+	if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+		info.ExpiresAt = exp.Time
+	}
+
+	return info, nil
+}
+
+// checkRevoked fails for tokens on the denylist. Tokens issued before
+// they carried a jti cannot be revoked and pass.
+func (us *userserver) checkRevoked(jti string) error {
+	if jti == "" {
+		return nil
+	}
+	revoked, err := us.redisRepo.IsRevoked(jti)
+	if err != nil {
+		return fmt.Errorf("check revoked: %w", err)
+	}
+	if revoked {
+		return fmt.Errorf("check revoked: %w", apierr.InvalidCredentials("Token has been revoked"))
+	}
+	return nil
+}